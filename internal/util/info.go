@@ -21,13 +21,21 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"time"
 
 	"github.com/oceanbase/obshell-sdk-go/log"
 	"github.com/oceanbase/obshell-sdk-go/model"
 )
 
 func GetInfo(server string) (*model.AgentRunStatus, error) {
-	resp, err := http.Get(fmt.Sprintf("http://%s/api/v1/info", server))
+	return GetInfoWithTimeout(server, 0)
+}
+
+// GetInfoWithTimeout is the same as GetInfo, but the request fails after timeout.
+// A zero timeout means no timeout.
+func GetInfoWithTimeout(server string, timeout time.Duration) (*model.AgentRunStatus, error) {
+	client := &http.Client{Timeout: timeout}
+	resp, err := client.Get(fmt.Sprintf("http://%s/api/v1/info", server))
 	if err != nil {
 		log.Warn("Failed to get version: Network error: %v", err)
 		return nil, err
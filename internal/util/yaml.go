@@ -0,0 +1,130 @@
+/*
+ * Copyright (c) 2024 OceanBase.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package util
+
+import (
+	"bytes"
+	"encoding/json"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// The strings which contain ':' or '#' are always quoted, as ": " and " #" are indicators in plain scalars,
+// and the strings starting with an indicator such as '-', '?' or '#' are quoted too.
+var (
+	yamlPlainString = regexp.MustCompile(`^[A-Za-z_./][A-Za-z0-9_ ./@()+-]*$`)
+	yamlKeywords    = []string{"true", "false", "yes", "no", "on", "off", "null", "y", "n", "~", ".inf", ".nan"}
+)
+
+// MarshalYAML renders v as YAML through its json encoding, so the json tags are respected.
+// The keys of the mappings are sorted to make the output stable.
+func MarshalYAML(v interface{}) ([]byte, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var value interface{}
+	if err = decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	switch value.(type) {
+	case map[string]interface{}, []interface{}:
+		writeYAMLValue(&buf, value, 0)
+	default:
+		buf.WriteString(yamlScalar(value))
+		buf.WriteString("\n")
+	}
+	return buf.Bytes(), nil
+}
+
+func writeYAMLValue(buf *bytes.Buffer, value interface{}, indent int) {
+	prefix := strings.Repeat("  ", indent)
+	switch v := value.(type) {
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			buf.WriteString(prefix + yamlScalar(key) + ":")
+			writeYAMLChild(buf, v[key], indent+1)
+		}
+	case []interface{}:
+		for _, item := range v {
+			buf.WriteString(prefix + "-")
+			if m, ok := item.(map[string]interface{}); ok && len(m) != 0 {
+				// Render the first key on the same line as the dash.
+				var child bytes.Buffer
+				writeYAMLValue(&child, m, indent+1)
+				buf.WriteString(" " + strings.TrimPrefix(child.String(), strings.Repeat("  ", indent+1)))
+				continue
+			}
+			writeYAMLChild(buf, item, indent+1)
+		}
+	}
+}
+
+// writeYAMLChild writes the value after a key or a dash.
+func writeYAMLChild(buf *bytes.Buffer, value interface{}, indent int) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		if len(v) == 0 {
+			buf.WriteString(" {}\n")
+			return
+		}
+		buf.WriteString("\n")
+		writeYAMLValue(buf, v, indent)
+	case []interface{}:
+		if len(v) == 0 {
+			buf.WriteString(" []\n")
+			return
+		}
+		buf.WriteString("\n")
+		writeYAMLValue(buf, v, indent)
+	default:
+		buf.WriteString(" " + yamlScalar(v) + "\n")
+	}
+}
+
+func yamlScalar(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		if v {
+			return "true"
+		}
+		return "false"
+	case json.Number:
+		return v.String()
+	case string:
+		if yamlPlainString.MatchString(v) && !strings.HasSuffix(v, " ") && !ContainsString(yamlKeywords, strings.ToLower(v)) {
+			return v
+		}
+		quoted, _ := json.Marshal(v)
+		return string(quoted)
+	default:
+		data, _ := json.Marshal(v)
+		return string(data)
+	}
+}
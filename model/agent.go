@@ -78,6 +78,14 @@ type AgentStatus struct {
 	UnderMaintenance bool `json:"underMaintenance"`
 }
 
+// The state of observer in AgentStatus.OBState.
+const (
+	OB_STATE_PROCESS_NOT_RUNNING = iota
+	OB_STATE_PROCESS_RUNNING
+	OB_STATE_CONNECTION_RESTRICTED
+	OB_STATE_CONNECTION_AVAILABLE
+)
+
 type AgentInfoWithIdentity struct {
 	AgentInfo
 	Identity AgentIdentity `json:"identity" binding:"required"`
@@ -90,6 +98,11 @@ type ClusterConfig struct {
 	ZoneConfig  map[string][]*ServerConfig `json:"topology"`
 }
 
+const (
+	SERVER_STATUS_ACTIVE   = "ACTIVE"
+	SERVER_STATUS_INACTIVE = "INACTIVE"
+)
+
 type ServerConfig struct {
 	SvrIP        string `json:"svr_ip"`
 	SvrPort      int    `json:"svr_port"`
@@ -100,6 +113,15 @@ type ServerConfig struct {
 	BuildVersion string `json:"build_version"`
 }
 
+// AgentAddr returns the address('ip:port') of the agent on the server.
+func (s *ServerConfig) AgentAddr() string {
+	return fmt.Sprintf("%s:%d", s.SvrIP, s.AgentPort)
+}
+
+func (s *ServerConfig) IsActive() bool {
+	return s.Status == SERVER_STATUS_ACTIVE
+}
+
 type Scope struct {
 	Type   string   `json:"type"`
 	Target []string `json:"target"`
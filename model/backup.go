@@ -46,3 +46,28 @@ type CdbObBackupTask struct {
 	Comment               string    `json:"comment"`
 	Path                  string    `json:"path"`
 }
+
+const (
+	ARCHIVE_LOG_STATUS_DOING     = "DOING"
+	ARCHIVE_LOG_STATUS_STOP      = "STOP"
+	ARCHIVE_LOG_STATUS_SUSPEND   = "SUSPEND"
+	ARCHIVE_LOG_STATUS_INTERRUPT = "INTERRUPTED"
+)
+
+// ArchiveLogStatus is the status of the log archive of a tenant.
+type ArchiveLogStatus struct {
+	TenantId             int       `json:"tenant_id"`
+	DestId               int       `json:"dest_id"`
+	RoundId              int       `json:"round_id"`
+	Status               string    `json:"status"`
+	Path                 string    `json:"path"`
+	StartScn             int64     `json:"start_scn"`
+	CheckpointScn        int64     `json:"checkpoint_scn"`
+	CheckpointScnDisplay time.Time `json:"checkpoint_scn_display"`
+	Comment              string    `json:"comment"`
+}
+
+// Lag returns the duration between now and the checkpoint of the archive.
+func (s *ArchiveLogStatus) Lag() time.Duration {
+	return time.Since(s.CheckpointScnDisplay)
+}
@@ -0,0 +1,297 @@
+/*
+ * Copyright (c) 2024 OceanBase.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	PRIMARY_ZONE_RANDOM = "RANDOM"
+
+	REPLICA_TYPE_FULL     = "FULL"
+	REPLICA_TYPE_READONLY = "READONLY"
+	REPLICA_TYPE_LOGONLY  = "LOGONLY"
+)
+
+var replicaTypeAbbreviations = map[string]string{
+	"F": REPLICA_TYPE_FULL,
+	"R": REPLICA_TYPE_READONLY,
+	"L": REPLICA_TYPE_LOGONLY,
+}
+
+// PrimaryZone is the primary zone of a tenant, such as 'zone1,zone2;zone3'.
+// The zones in the same group have the same priority, and the groups are in descending order of priority.
+// The primary zone 'RANDOM' means all the zones have the same priority, it has no group.
+type PrimaryZone struct {
+	Random bool
+	Groups [][]string
+}
+
+// ParsePrimaryZone parses the primary zone string, such as TenantOverview.PrimaryZone.
+func ParsePrimaryZone(primaryZone string) (*PrimaryZone, error) {
+	primaryZone = strings.TrimSpace(primaryZone)
+	if strings.EqualFold(primaryZone, PRIMARY_ZONE_RANDOM) {
+		return &PrimaryZone{Random: true}, nil
+	}
+	if primaryZone == "" {
+		return nil, fmt.Errorf("empty primary zone")
+	}
+	p := &PrimaryZone{Groups: make([][]string, 0)}
+	seen := make(map[string]bool)
+	for _, groupStr := range strings.Split(primaryZone, ";") {
+		group := make([]string, 0)
+		for _, zone := range strings.Split(groupStr, ",") {
+			zone = strings.TrimSpace(zone)
+			if zone == "" {
+				return nil, fmt.Errorf("invalid primary zone: '%s'", primaryZone)
+			}
+			if seen[zone] {
+				return nil, fmt.Errorf("duplicate zone '%s' in primary zone", zone)
+			}
+			seen[zone] = true
+			group = append(group, zone)
+		}
+		p.Groups = append(p.Groups, group)
+	}
+	return p, nil
+}
+
+// Zones returns all the zones in the primary zone in order of priority.
+func (p *PrimaryZone) Zones() []string {
+	zones := make([]string, 0)
+	for _, group := range p.Groups {
+		zones = append(zones, group...)
+	}
+	return zones
+}
+
+// Validate checks whether all the zones in the primary zone exist in the given zones.
+func (p *PrimaryZone) Validate(clusterZones []string) error {
+	return checkZonesExist(p.Zones(), clusterZones)
+}
+
+// ValidateWithLocality checks whether all the zones in the primary zone have FULL replica in the locality.
+func (p *PrimaryZone) ValidateWithLocality(l *Locality) error {
+	fullZones := make([]string, 0)
+	for _, replica := range l.Replicas {
+		if replica.Type == REPLICA_TYPE_FULL {
+			fullZones = append(fullZones, replica.Zone)
+		}
+	}
+	for _, zone := range p.Zones() {
+		if !containsString(fullZones, zone) {
+			return fmt.Errorf("primary zone '%s' has no FULL replica in locality", zone)
+		}
+	}
+	return nil
+}
+
+// String renders the primary zone, which can be used by SetTenantPrimaryZone.
+func (p *PrimaryZone) String() string {
+	if p.Random {
+		return PRIMARY_ZONE_RANDOM
+	}
+	groups := make([]string, 0, len(p.Groups))
+	for _, group := range p.Groups {
+		groups = append(groups, strings.Join(group, ","))
+	}
+	return strings.Join(groups, ";")
+}
+
+// ReplicaDescriptor is a replica descriptor of the locality, such as 'FULL{1}@zone1'.
+type ReplicaDescriptor struct {
+	Type string // REPLICA_TYPE_FULL, REPLICA_TYPE_READONLY or REPLICA_TYPE_LOGONLY.
+	Num  int    // REPLICA_NUM_ALL means '{ALL}'.
+	Zone string
+}
+
+// REPLICA_NUM_ALL is the replica number of '{ALL}', such as 'R{ALL}@zone1'.
+const REPLICA_NUM_ALL = -1
+
+// String renders the replica descriptor.
+func (r ReplicaDescriptor) String() string {
+	return fmt.Sprintf("%s@%s", r.typeString(), r.Zone)
+}
+
+func (r ReplicaDescriptor) typeString() string {
+	if r.Num == REPLICA_NUM_ALL {
+		return fmt.Sprintf("%s{ALL}", r.Type)
+	}
+	return fmt.Sprintf("%s{%d}", r.Type, r.Num)
+}
+
+// Locality is the locality of a tenant, such as 'FULL{1}@zone1, READONLY{1}@zone2'.
+// A zone may have several replicas of different types, such as 'F{1},R{1}@zone1'.
+type Locality struct {
+	Replicas []ReplicaDescriptor
+}
+
+// ParseLocality parses the locality string, such as TenantOverview.Locality.
+// Both the full form 'FULL{1}@zone1' and the abbreviation 'F@zone1' are supported,
+// the replica number can be omitted or 'ALL', and the replicas before '@zone' all belong to the zone,
+// such as 'F{1},R{ALL}@zone1, F@zone2'.
+func ParseLocality(locality string) (*Locality, error) {
+	l := &Locality{Replicas: make([]ReplicaDescriptor, 0)}
+	if strings.TrimSpace(locality) == "" {
+		return nil, fmt.Errorf("empty locality")
+	}
+	seen := make(map[string]bool) // zone@type
+	pending := make([]string, 0)
+	for _, item := range strings.Split(locality, ",") {
+		item = strings.TrimSpace(item)
+		parts := strings.SplitN(item, "@", 2)
+		pending = append(pending, parts[0])
+		if len(parts) == 1 {
+			continue
+		}
+		zone := strings.TrimSpace(parts[1])
+		if zone == "" {
+			return nil, fmt.Errorf("invalid replica descriptor: '%s'", item)
+		}
+		for _, typeStr := range pending {
+			replica, err := parseReplicaDescriptor(typeStr, zone)
+			if err != nil {
+				return nil, err
+			}
+			if seen[zone+"@"+replica.Type] {
+				return nil, fmt.Errorf("duplicate %s replica of zone '%s' in locality", replica.Type, zone)
+			}
+			seen[zone+"@"+replica.Type] = true
+			l.Replicas = append(l.Replicas, replica)
+		}
+		pending = pending[:0]
+	}
+	if len(pending) != 0 {
+		return nil, fmt.Errorf("invalid locality: '%s', the zone of '%s' is missing", locality, strings.Join(pending, ","))
+	}
+	return l, nil
+}
+
+func parseReplicaDescriptor(typeStr string, zone string) (replica ReplicaDescriptor, err error) {
+	item := typeStr + "@" + zone
+	replica.Zone = zone
+	replica.Num = 1
+
+	typeStr = strings.ToUpper(strings.TrimSpace(typeStr))
+	if i := strings.Index(typeStr, "{"); i >= 0 {
+		if !strings.HasSuffix(typeStr, "}") {
+			return replica, fmt.Errorf("invalid replica descriptor: '%s'", item)
+		}
+		numStr := strings.TrimSpace(typeStr[i+1 : len(typeStr)-1])
+		if numStr == "ALL" {
+			replica.Num = REPLICA_NUM_ALL
+		} else if replica.Num, err = strconv.Atoi(numStr); err != nil || replica.Num <= 0 {
+			return replica, fmt.Errorf("invalid replica number: '%s'", item)
+		}
+		typeStr = strings.TrimSpace(typeStr[:i])
+	}
+	if t, ok := replicaTypeAbbreviations[typeStr]; ok {
+		typeStr = t
+	}
+	switch typeStr {
+	case REPLICA_TYPE_FULL, REPLICA_TYPE_READONLY, REPLICA_TYPE_LOGONLY:
+		replica.Type = typeStr
+	default:
+		return replica, fmt.Errorf("invalid replica type: '%s'", item)
+	}
+	return replica, nil
+}
+
+// Zones returns all the zones in the locality, in order of appearance.
+func (l *Locality) Zones() []string {
+	zones := make([]string, 0, len(l.Replicas))
+	for _, replica := range l.Replicas {
+		if !containsString(zones, replica.Zone) {
+			zones = append(zones, replica.Zone)
+		}
+	}
+	return zones
+}
+
+// GetReplicas returns all the replica descriptors of the zone.
+func (l *Locality) GetReplicas(zone string) []ReplicaDescriptor {
+	replicas := make([]ReplicaDescriptor, 0)
+	for _, replica := range l.Replicas {
+		if replica.Zone == zone {
+			replicas = append(replicas, replica)
+		}
+	}
+	return replicas
+}
+
+// GetReplica returns the replica descriptor of the zone, or nil if the zone is not in the locality.
+// The FULL replica is preferred when the zone has several replicas.
+func (l *Locality) GetReplica(zone string) *ReplicaDescriptor {
+	var replica *ReplicaDescriptor
+	for i := range l.Replicas {
+		if l.Replicas[i].Zone != zone {
+			continue
+		}
+		if replica == nil || l.Replicas[i].Type == REPLICA_TYPE_FULL {
+			replica = &l.Replicas[i]
+		}
+	}
+	return replica
+}
+
+// Validate checks whether all the zones in the locality exist in the given zones.
+func (l *Locality) Validate(clusterZones []string) error {
+	return checkZonesExist(l.Zones(), clusterZones)
+}
+
+// String renders the locality, the replicas of the same zone are grouped, such as 'FULL{1},READONLY{1}@zone1'.
+func (l *Locality) String() string {
+	items := make([]string, 0)
+	for _, zone := range l.Zones() {
+		types := make([]string, 0)
+		for _, replica := range l.GetReplicas(zone) {
+			types = append(types, replica.typeString())
+		}
+		items = append(items, fmt.Sprintf("%s@%s", strings.Join(types, ","), zone))
+	}
+	return strings.Join(items, ", ")
+}
+
+// Zones returns all the zones of the cluster.
+func (c *ClusterConfig) Zones() []string {
+	zones := make([]string, 0, len(c.ZoneConfig))
+	for zone := range c.ZoneConfig {
+		zones = append(zones, zone)
+	}
+	return zones
+}
+
+func checkZonesExist(zones []string, clusterZones []string) error {
+	for _, zone := range zones {
+		if !containsString(clusterZones, zone) {
+			return fmt.Errorf("zone '%s' does not exist in cluster", zone)
+		}
+	}
+	return nil
+}
+
+func containsString(list []string, item string) bool {
+	for _, v := range list {
+		if v == item {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,242 @@
+/*
+ * Copyright (c) 2024 OceanBase.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Data type of the tenant parameter.
+const (
+	PARAMETER_DATA_TYPE_BOOL     = "BOOL"
+	PARAMETER_DATA_TYPE_INT      = "INT"
+	PARAMETER_DATA_TYPE_DOUBLE   = "DOUBLE"
+	PARAMETER_DATA_TYPE_TIME     = "TIME"
+	PARAMETER_DATA_TYPE_CAPACITY = "CAPACITY"
+	PARAMETER_DATA_TYPE_STRING   = "STRING"
+)
+
+// Edit level of the tenant parameter.
+const (
+	EDIT_LEVEL_READONLY          = "READONLY"
+	EDIT_LEVEL_STATIC_EFFECTIVE  = "STATIC_EFFECTIVE"  // Need to restart the observer to take effect.
+	EDIT_LEVEL_DYNAMIC_EFFECTIVE = "DYNAMIC_EFFECTIVE" // Take effect immediately.
+)
+
+var capacityUnits = map[string]int64{
+	"B": 1,
+	"K": 1 << 10,
+	"M": 1 << 20,
+	"G": 1 << 30,
+	"T": 1 << 40,
+	"P": 1 << 50,
+}
+
+var timeUnits = map[string]time.Duration{
+	"US": time.Microsecond,
+	"MS": time.Millisecond,
+	"S":  time.Second,
+	"M":  time.Minute,
+	"H":  time.Hour,
+	"D":  24 * time.Hour,
+}
+
+func splitNumberAndUnit(value string) (number string, unit string) {
+	value = strings.TrimSpace(value)
+	i := len(value)
+	for i > 0 && (value[i-1] < '0' || value[i-1] > '9') {
+		i--
+	}
+	return value[:i], strings.ToUpper(strings.TrimSpace(value[i:]))
+}
+
+// ParseBool parses the value of a BOOL parameter or a variable, such as "True", "false", "ON" and "0".
+func ParseBool(value string) (bool, error) {
+	switch strings.ToUpper(strings.TrimSpace(value)) {
+	case "TRUE", "ON", "1", "YES":
+		return true, nil
+	case "FALSE", "OFF", "0", "NO":
+		return false, nil
+	}
+	return false, fmt.Errorf("invalid bool value: '%s'", value)
+}
+
+// ParseCapacity parses the value of a CAPACITY parameter, such as "2G", "1.5G" and "512M", and returns the size in bytes.
+// The value without unit is considered as MB, which is the default unit of OceanBase.
+func ParseCapacity(value string) (int64, error) {
+	number, unit := splitNumberAndUnit(value)
+	n, err := strconv.ParseFloat(number, 64)
+	if err != nil || n < 0 {
+		return 0, fmt.Errorf("invalid capacity value: '%s'", value)
+	}
+	if unit == "" {
+		unit = "M"
+	}
+	unit = strings.TrimSuffix(unit, "B")
+	if unit == "" {
+		unit = "B"
+	}
+	multiple, ok := capacityUnits[unit]
+	if !ok {
+		return 0, fmt.Errorf("invalid capacity unit: '%s'", value)
+	}
+	return int64(n * float64(multiple)), nil
+}
+
+// ParseDuration parses the value of a TIME parameter, such as "10s" and "100ms".
+// The value without unit is considered as second.
+func ParseDuration(value string) (time.Duration, error) {
+	number, unit := splitNumberAndUnit(value)
+	n, err := strconv.ParseInt(number, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time value: '%s'", value)
+	}
+	if unit == "" {
+		unit = "S"
+	}
+	multiple, ok := timeUnits[unit]
+	if !ok {
+		return 0, fmt.Errorf("invalid time unit: '%s'", value)
+	}
+	return time.Duration(n) * multiple, nil
+}
+
+// ValidateParameterValue checks whether the value matches the data type of the parameter.
+// The value of unknown data type is always valid.
+func ValidateParameterValue(dataType string, value string) (err error) {
+	switch strings.ToUpper(dataType) {
+	case PARAMETER_DATA_TYPE_BOOL:
+		_, err = ParseBool(value)
+	case PARAMETER_DATA_TYPE_INT:
+		_, err = strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+	case PARAMETER_DATA_TYPE_DOUBLE:
+		_, err = strconv.ParseFloat(strings.TrimSpace(value), 64)
+	case PARAMETER_DATA_TYPE_TIME:
+		_, err = ParseDuration(value)
+	case PARAMETER_DATA_TYPE_CAPACITY:
+		_, err = ParseCapacity(value)
+	}
+	return err
+}
+
+// EqualParameterValue reports whether the two values of the parameter are equal after being parsed with the data type,
+// for example, "1G" and "1024M" are equal for a CAPACITY parameter.
+func EqualParameterValue(dataType string, v1, v2 string) bool {
+	switch strings.ToUpper(dataType) {
+	case PARAMETER_DATA_TYPE_BOOL:
+		b1, err1 := ParseBool(v1)
+		b2, err2 := ParseBool(v2)
+		if err1 == nil && err2 == nil {
+			return b1 == b2
+		}
+	case PARAMETER_DATA_TYPE_INT:
+		i1, err1 := strconv.ParseInt(strings.TrimSpace(v1), 10, 64)
+		i2, err2 := strconv.ParseInt(strings.TrimSpace(v2), 10, 64)
+		if err1 == nil && err2 == nil {
+			return i1 == i2
+		}
+	case PARAMETER_DATA_TYPE_DOUBLE:
+		f1, err1 := strconv.ParseFloat(strings.TrimSpace(v1), 64)
+		f2, err2 := strconv.ParseFloat(strings.TrimSpace(v2), 64)
+		if err1 == nil && err2 == nil {
+			return f1 == f2
+		}
+	case PARAMETER_DATA_TYPE_TIME:
+		d1, err1 := ParseDuration(v1)
+		d2, err2 := ParseDuration(v2)
+		if err1 == nil && err2 == nil {
+			return d1 == d2
+		}
+	case PARAMETER_DATA_TYPE_CAPACITY:
+		c1, err1 := ParseCapacity(v1)
+		c2, err2 := ParseCapacity(v2)
+		if err1 == nil && err2 == nil {
+			return c1 == c2
+		}
+	}
+	return strings.EqualFold(strings.TrimSpace(v1), strings.TrimSpace(v2))
+}
+
+func (p *ParameterInfo) checkDataType(dataType string) error {
+	if p.DataType != "" && !strings.EqualFold(p.DataType, dataType) {
+		return fmt.Errorf("parameter '%s' is %s, not %s", p.Name, p.DataType, dataType)
+	}
+	return nil
+}
+
+// Bool returns the value of a BOOL parameter.
+func (p *ParameterInfo) Bool() (bool, error) {
+	if err := p.checkDataType(PARAMETER_DATA_TYPE_BOOL); err != nil {
+		return false, err
+	}
+	return ParseBool(p.Value)
+}
+
+// Int returns the value of an INT parameter.
+func (p *ParameterInfo) Int() (int64, error) {
+	if err := p.checkDataType(PARAMETER_DATA_TYPE_INT); err != nil {
+		return 0, err
+	}
+	return strconv.ParseInt(strings.TrimSpace(p.Value), 10, 64)
+}
+
+// Float returns the value of a DOUBLE parameter.
+func (p *ParameterInfo) Float() (float64, error) {
+	if err := p.checkDataType(PARAMETER_DATA_TYPE_DOUBLE); err != nil {
+		return 0, err
+	}
+	return strconv.ParseFloat(strings.TrimSpace(p.Value), 64)
+}
+
+// Duration returns the value of a TIME parameter.
+func (p *ParameterInfo) Duration() (time.Duration, error) {
+	if err := p.checkDataType(PARAMETER_DATA_TYPE_TIME); err != nil {
+		return 0, err
+	}
+	return ParseDuration(p.Value)
+}
+
+// Capacity returns the value of a CAPACITY parameter in bytes.
+func (p *ParameterInfo) Capacity() (int64, error) {
+	if err := p.checkDataType(PARAMETER_DATA_TYPE_CAPACITY); err != nil {
+		return 0, err
+	}
+	return ParseCapacity(p.Value)
+}
+
+// IsReadOnly reports whether the parameter can not be modified.
+func (p *ParameterInfo) IsReadOnly() bool {
+	return strings.EqualFold(p.EditLevel, EDIT_LEVEL_READONLY)
+}
+
+// NeedRestart reports whether the observer needs to be restarted to make the modification take effect.
+func (p *ParameterInfo) NeedRestart() bool {
+	return strings.EqualFold(p.EditLevel, EDIT_LEVEL_STATIC_EFFECTIVE)
+}
+
+// Bool returns the value of a variable as bool, such as "ON" and "OFF".
+func (v *VariableInfo) Bool() (bool, error) {
+	return ParseBool(v.Value)
+}
+
+// Int returns the value of a variable as int.
+func (v *VariableInfo) Int() (int64, error) {
+	return strconv.ParseInt(strings.TrimSpace(v.Value), 10, 64)
+}
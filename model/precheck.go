@@ -0,0 +1,106 @@
+/*
+ * Copyright (c) 2024 OceanBase.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	PRECHECK_PASSED  = "PASSED"
+	PRECHECK_WARNING = "WARNING"
+	PRECHECK_FAILED  = "FAILED"
+)
+
+// PrecheckItem is the result of a check on a server.
+type PrecheckItem struct {
+	Server  string `json:"server"`
+	Name    string `json:"name"`
+	Result  string `json:"result"`
+	Message string `json:"message"`
+}
+
+// PrecheckReport is the report of the checks before deploying a cluster.
+type PrecheckReport struct {
+	Items []PrecheckItem `json:"items"`
+}
+
+func NewPrecheckReport() *PrecheckReport {
+	return &PrecheckReport{Items: make([]PrecheckItem, 0)}
+}
+
+func (r *PrecheckReport) Add(server, name, result, message string) {
+	r.Items = append(r.Items, PrecheckItem{
+		Server:  server,
+		Name:    name,
+		Result:  result,
+		Message: message,
+	})
+}
+
+func (r *PrecheckReport) Pass(server, name, message string) {
+	r.Add(server, name, PRECHECK_PASSED, message)
+}
+
+func (r *PrecheckReport) Warn(server, name, message string) {
+	r.Add(server, name, PRECHECK_WARNING, message)
+}
+
+func (r *PrecheckReport) Fail(server, name, message string) {
+	r.Add(server, name, PRECHECK_FAILED, message)
+}
+
+func (r *PrecheckReport) filter(result string) []PrecheckItem {
+	items := make([]PrecheckItem, 0)
+	for _, item := range r.Items {
+		if item.Result == result {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+func (r *PrecheckReport) Passes() []PrecheckItem {
+	return r.filter(PRECHECK_PASSED)
+}
+
+func (r *PrecheckReport) Warnings() []PrecheckItem {
+	return r.filter(PRECHECK_WARNING)
+}
+
+func (r *PrecheckReport) Failures() []PrecheckItem {
+	return r.filter(PRECHECK_FAILED)
+}
+
+// HasFailure returns true if any check is failed.
+func (r *PrecheckReport) HasFailure() bool {
+	return len(r.Failures()) != 0
+}
+
+// Error returns an error which contains all the failures, or nil if there is no failure.
+func (r *PrecheckReport) Error() error {
+	failures := r.Failures()
+	if len(failures) == 0 {
+		return nil
+	}
+	messages := make([]string, 0, len(failures))
+	for _, item := range failures {
+		messages = append(messages, fmt.Sprintf("[%s] %s: %s", item.Server, item.Name, item.Message))
+	}
+	return fmt.Errorf("precheck failed: %s", strings.Join(messages, "; "))
+}
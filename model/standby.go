@@ -0,0 +1,68 @@
+/*
+ * Copyright (c) 2024 OceanBase.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+type TenantRole string
+
+const (
+	TENANT_ROLE_PRIMARY TenantRole = "PRIMARY"
+	TENANT_ROLE_STANDBY TenantRole = "STANDBY"
+	TENANT_ROLE_RESTORE TenantRole = "RESTORE"
+)
+
+// Sync status of the standby tenant.
+const (
+	STANDBY_SYNC_STATUS_NORMAL = "NORMAL"
+)
+
+// TenantRoleInfo is the role and the log sync status of a tenant.
+// The sync fields are only meaningful for the standby tenant.
+type TenantRoleInfo struct {
+	TenantName       string     `json:"tenant_name"`
+	TenantId         int        `json:"tenant_id"`
+	TenantRole       TenantRole `json:"tenant_role"`
+	SwitchoverStatus string     `json:"switchover_status"`
+	LogMode          string     `json:"log_mode"`
+	LogRestoreSource string     `json:"log_restore_source"` // The log source of the standby tenant, without password.
+	SyncScn          int64      `json:"sync_scn"`
+	ReplayableScn    int64      `json:"replayable_scn"`
+	ReadableScn      int64      `json:"readable_scn"`
+	RecoveryUntilScn int64      `json:"recovery_until_scn"`
+	SyncStatus       string     `json:"sync_status"`
+	ReplayLag        int64      `json:"replay_lag"` // The lag between the latest log of the primary tenant and the readable scn of the standby tenant, in seconds.
+}
+
+func (t *TenantRoleInfo) IsPrimary() bool {
+	return t.TenantRole == TENANT_ROLE_PRIMARY
+}
+
+func (t *TenantRoleInfo) IsStandby() bool {
+	return t.TenantRole == TENANT_ROLE_STANDBY
+}
+
+// IsSyncNormal reports whether the standby tenant is syncing logs from the log source normally.
+func (t *TenantRoleInfo) IsSyncNormal() bool {
+	return t.SyncStatus == STANDBY_SYNC_STATUS_NORMAL
+}
+
+// PrimaryTenantLogSource is the log source of a standby tenant, which syncs logs from the primary tenant over network.
+type PrimaryTenantLogSource struct {
+	IpList     []string `json:"ip_list" binding:"required"` // The 'ip:sql_port' of the observers of the primary tenant.
+	TenantName string   `json:"tenant_name" binding:"required"`
+	User       string   `json:"user" binding:"required"` // The user of the primary tenant used to sync logs.
+	Password   string   `json:"password"`
+}
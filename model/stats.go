@@ -0,0 +1,83 @@
+/*
+ * Copyright (c) 2024 OceanBase.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+// UnitResourceStats is the resource usage of a unit against its unit config.
+type UnitResourceStats struct {
+	UnitId        int     `json:"unit_id"`
+	Zone          string  `json:"zone"`
+	SvrIp         string  `json:"svr_ip"`
+	SvrPort       int     `json:"svr_port"`
+	CpuLimit      float64 `json:"cpu_limit"` // The max_cpu of the unit config.
+	CpuUsed       float64 `json:"cpu_used"`
+	MemoryLimit   int64   `json:"memory_limit"` // In bytes.
+	MemoryUsed    int64   `json:"memory_used"`
+	LogDiskLimit  int64   `json:"log_disk_limit"`
+	LogDiskUsed   int64   `json:"log_disk_used"`
+	DataDiskLimit int64   `json:"data_disk_limit"` // 0 means unlimited.
+	DataDiskUsed  int64   `json:"data_disk_used"`
+}
+
+// TenantResourceStats is the resource usage of a tenant, which is the sum of all its units.
+type TenantResourceStats struct {
+	TenantName    string              `json:"tenant_name"`
+	TenantId      int                 `json:"tenant_id"`
+	CpuLimit      float64             `json:"cpu_limit"`
+	CpuUsed       float64             `json:"cpu_used"`
+	MemoryLimit   int64               `json:"memory_limit"` // In bytes.
+	MemoryUsed    int64               `json:"memory_used"`
+	LogDiskLimit  int64               `json:"log_disk_limit"`
+	LogDiskUsed   int64               `json:"log_disk_used"`
+	DataDiskLimit int64               `json:"data_disk_limit"` // 0 means unlimited.
+	DataDiskUsed  int64               `json:"data_disk_used"`
+	Units         []UnitResourceStats `json:"units"`
+}
+
+// Resource kind of the utilization.
+const (
+	RESOURCE_CPU       = "CPU"
+	RESOURCE_MEMORY    = "MEMORY"
+	RESOURCE_LOG_DISK  = "LOG_DISK"
+	RESOURCE_DATA_DISK = "DATA_DISK"
+)
+
+func utilization(used float64, limit float64) float64 {
+	if limit <= 0 {
+		return 0
+	}
+	return used / limit
+}
+
+// Utilization returns the ratio of used to limit of each resource, the resource without limit is 0.
+func (t *TenantResourceStats) Utilization() map[string]float64 {
+	return map[string]float64{
+		RESOURCE_CPU:       utilization(t.CpuUsed, t.CpuLimit),
+		RESOURCE_MEMORY:    utilization(float64(t.MemoryUsed), float64(t.MemoryLimit)),
+		RESOURCE_LOG_DISK:  utilization(float64(t.LogDiskUsed), float64(t.LogDiskLimit)),
+		RESOURCE_DATA_DISK: utilization(float64(t.DataDiskUsed), float64(t.DataDiskLimit)),
+	}
+}
+
+// Utilization returns the ratio of used to limit of each resource, the resource without limit is 0.
+func (u *UnitResourceStats) Utilization() map[string]float64 {
+	return map[string]float64{
+		RESOURCE_CPU:       utilization(u.CpuUsed, u.CpuLimit),
+		RESOURCE_MEMORY:    utilization(float64(u.MemoryUsed), float64(u.MemoryLimit)),
+		RESOURCE_LOG_DISK:  utilization(float64(u.LogDiskUsed), float64(u.LogDiskLimit)),
+		RESOURCE_DATA_DISK: utilization(float64(u.DataDiskUsed), float64(u.DataDiskLimit)),
+	}
+}
@@ -16,7 +16,12 @@
 
 package model
 
-import "time"
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
 
 type ResourcePoolInfo struct {
 	Name         string `json:"name"`
@@ -83,8 +88,68 @@ type ParameterInfo struct {
 }
 
 type RecycledTenantOverView struct {
-	Name         string `json:"object_name"`
-	OriginalName string `json:"original_tenant_name"`
-	CanUndrop    string `json:"can_undrop"`
-	CanPurge     string `json:"can_purge"`
+	Name         string     `json:"object_name"`
+	OriginalName string     `json:"original_tenant_name"`
+	CanUndrop    string     `json:"can_undrop"`
+	CanPurge     string     `json:"can_purge"`
+	DropTime     *time.Time `json:"drop_time"` // Only returned by the newer agent, use GetDropTime instead.
+}
+
+// ResourcePoolSplitTarget describes one of the new pools produced by splitting a resource pool,
+// every new pool takes exactly one zone of the original pool.
+type ResourcePoolSplitTarget struct {
+	PoolName string `json:"pool_name"`
+	ZoneName string `json:"zone_name"`
+}
+
+type TenantUser struct {
+	UserName         string        `json:"user_name"`
+	HostName         string        `json:"host_name"`
+	IsLocked         bool          `json:"is_locked"`
+	CreateTime       time.Time     `json:"create_time"`
+	GlobalPrivileges []string      `json:"global_privileges"`
+	DbPrivileges     []DbPrivilege `json:"db_privileges"`
+}
+
+// DbPrivilege is the privileges of a user on a database, such as "SELECT", "INSERT" and "ALL PRIVILEGES".
+type DbPrivilege struct {
+	DbName     string   `json:"db_name"`
+	Privileges []string `json:"privileges"`
+}
+
+type TenantDatabase struct {
+	DbName       string    `json:"db_name"`
+	Charset      string    `json:"charset"`
+	Collation    string    `json:"collation"`
+	ReadOnly     bool      `json:"read_only"`
+	Comment      string    `json:"comment"`
+	DataSize     int64     `json:"data_size"`     // The size of the data in bytes.
+	RequiredSize int64     `json:"required_size"` // The size of the disk space required by the data in bytes.
+	CreateTime   time.Time `json:"create_time"`
+}
+
+// GetDropTime returns the time when the tenant was dropped.
+// If the agent does not return the drop time, it will be parsed from the object name,
+// which is formatted as '__recycle_$_{cluster_id}_{drop_timestamp_us}'.
+func (t *RecycledTenantOverView) GetDropTime() (time.Time, error) {
+	if t.DropTime != nil {
+		return *t.DropTime, nil
+	}
+	i := strings.LastIndex(t.Name, "_")
+	if i < 0 {
+		return time.Time{}, fmt.Errorf("invalid recyclebin object name: '%s'", t.Name)
+	}
+	us, err := strconv.ParseInt(t.Name[i+1:], 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid recyclebin object name: '%s'", t.Name)
+	}
+	return time.UnixMicro(us), nil
+}
+
+func (t *RecycledTenantOverView) IsPurgeable() bool {
+	return strings.EqualFold(t.CanPurge, "YES")
+}
+
+func (t *RecycledTenantOverView) CanFlashback() bool {
+	return strings.EqualFold(t.CanUndrop, "YES")
 }
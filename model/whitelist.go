@@ -0,0 +1,183 @@
+/*
+ * Copyright (c) 2024 OceanBase.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"fmt"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const WHITELIST_ALLOW_ALL = "%"
+
+var wildcardIpOctetRegexp = regexp.MustCompile(`^[0-9%_]{1,3}$`)
+
+// Whitelist is the whitelist of a tenant(ob_tcp_invited_nodes).
+// It supports IP, CIDR('192.168.1.0/24' or '192.168.1.0/255.255.255.0') and
+// wildcard('192.168.1.%', '192.168.1._' or '%') entries.
+// The entries keep the order they were added. Only the entries passed to Add are validated and normalized,
+// the parsed entries are kept verbatim, so that the entries unknown to the SDK(such as a hostname) are not lost.
+type Whitelist struct {
+	entries []string
+}
+
+// ParseWhitelist parses a comma-separated whitelist, such as TenantInfo.WhiteList.
+// The entries are kept verbatim without validation, only the empty ones are dropped.
+func ParseWhitelist(whitelist string) (*Whitelist, error) {
+	w := &Whitelist{entries: make([]string, 0)}
+	for _, entry := range strings.Split(whitelist, ",") {
+		if strings.TrimSpace(entry) != "" {
+			w.entries = append(w.entries, entry)
+		}
+	}
+	return w, nil
+}
+
+// whitelistEntryKey returns the form of the entry used for comparison,
+// which is the normalized one, or the trimmed one if the entry is unknown to the SDK.
+func whitelistEntryKey(entry string) string {
+	if e, err := NormalizeWhitelistEntry(entry); err == nil {
+		return e
+	}
+	return strings.TrimSpace(entry)
+}
+
+// NormalizeWhitelistEntry validates an entry and returns its normalized form,
+// for example, '192.168.1.5/24' will be normalized to '192.168.1.0/24'.
+func NormalizeWhitelistEntry(entry string) (string, error) {
+	entry = strings.TrimSpace(entry)
+	if entry == "" {
+		return "", fmt.Errorf("empty whitelist entry")
+	}
+	if strings.Contains(entry, "/") {
+		parts := strings.SplitN(entry, "/", 2)
+		ip := net.ParseIP(parts[0])
+		if ip == nil {
+			return "", fmt.Errorf("invalid whitelist entry: '%s'", entry)
+		}
+		if mask := net.ParseIP(parts[1]); mask != nil && ip.To4() != nil && mask.To4() != nil {
+			ones, bits := net.IPMask(mask.To4()).Size()
+			if bits == 0 {
+				return "", fmt.Errorf("invalid netmask of whitelist entry: '%s'", entry)
+			}
+			entry = fmt.Sprintf("%s/%d", parts[0], ones)
+		}
+		_, ipNet, err := net.ParseCIDR(entry)
+		if err != nil {
+			return "", fmt.Errorf("invalid whitelist entry: '%s'", entry)
+		}
+		return ipNet.String(), nil
+	}
+	if ip := net.ParseIP(entry); ip != nil {
+		return ip.String(), nil
+	}
+	if isWildcardIp(entry) {
+		return entry, nil
+	}
+	return "", fmt.Errorf("invalid whitelist entry: '%s'", entry)
+}
+
+// isWildcardIp checks the wildcard entry such as '192.168.1.%', '192.168.1._' or '%'.
+// It must have 4 octets unless the last one contains '%', and every octet must be able to match a number in [0, 255].
+func isWildcardIp(entry string) bool {
+	octets := strings.Split(entry, ".")
+	if len(octets) > 4 || (len(octets) < 4 && !strings.Contains(octets[len(octets)-1], "%")) {
+		return false
+	}
+	for _, octet := range octets {
+		if !wildcardIpOctetRegexp.MatchString(octet) {
+			return false
+		}
+		if strings.Contains(octet, "%") {
+			continue
+		}
+		// '_' matches one digit, the octet is valid if it can match a number not greater than 255.
+		if n, _ := strconv.Atoi(strings.ReplaceAll(octet, "_", "0")); n > 255 {
+			return false
+		}
+	}
+	return true
+}
+
+// Add validates and adds the normalized entries, the entries already in the whitelist will be ignored.
+// Nothing will be added if any entry is invalid.
+func (w *Whitelist) Add(entries ...string) error {
+	normalized := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		e, err := NormalizeWhitelistEntry(entry)
+		if err != nil {
+			return err
+		}
+		normalized = append(normalized, e)
+	}
+	for _, e := range normalized {
+		if !w.Contains(e) {
+			w.entries = append(w.entries, e)
+		}
+	}
+	return nil
+}
+
+// Remove validates and removes the entries, the entries not in the whitelist will be ignored.
+// The entries in the whitelist are compared after normalized, the others are kept verbatim.
+func (w *Whitelist) Remove(entries ...string) error {
+	for _, entry := range entries {
+		e, err := NormalizeWhitelistEntry(entry)
+		if err != nil {
+			return err
+		}
+		kept := make([]string, 0, len(w.entries))
+		for _, v := range w.entries {
+			if whitelistEntryKey(v) != e {
+				kept = append(kept, v)
+			}
+		}
+		w.entries = kept
+	}
+	return nil
+}
+
+// Contains reports whether the entry is in the whitelist literally(after normalized).
+func (w *Whitelist) Contains(entry string) bool {
+	e, err := NormalizeWhitelistEntry(entry)
+	if err != nil {
+		return false
+	}
+	for _, v := range w.entries {
+		if whitelistEntryKey(v) == e {
+			return true
+		}
+	}
+	return false
+}
+
+// Entries returns a copy of the entries.
+func (w *Whitelist) Entries() []string {
+	return append([]string{}, w.entries...)
+}
+
+// Len returns the number of the entries.
+func (w *Whitelist) Len() int {
+	return len(w.entries)
+}
+
+// String returns the comma-separated whitelist, which can be used by SetTenantWhitelist.
+func (w *Whitelist) String() string {
+	return strings.Join(w.entries, ",")
+}
@@ -0,0 +1,86 @@
+/*
+ * Copyright (c) 2024 OceanBase.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+const (
+	ZONE_STATUS_ACTIVE   = "ACTIVE"
+	ZONE_STATUS_INACTIVE = "INACTIVE"
+)
+
+// ZoneInfo is the status of a zone, with the servers and the units on it.
+type ZoneInfo struct {
+	Name    string        `json:"name"`
+	Region  string        `json:"region"`
+	Idc     string        `json:"idc"`
+	Status  string        `json:"status"`
+	Servers []*ZoneServer `json:"servers"`
+	Units   []*ZoneUnit   `json:"units"`
+}
+
+type ZoneServer struct {
+	SvrIp       string `json:"svr_ip"`
+	SvrPort     int    `json:"svr_port"`
+	SqlPort     int    `json:"sql_port"`
+	AgentPort   int    `json:"agent_port"`
+	Status      string `json:"status"`
+	WithRootSvr string `json:"with_rootserver"`
+}
+
+type ZoneUnit struct {
+	UnitId           int     `json:"unit_id"`
+	TenantId         int     `json:"tenant_id"`
+	TenantName       string  `json:"tenant_name"`
+	ResourcePoolName string  `json:"resource_pool_name"`
+	SvrIp            string  `json:"svr_ip"`
+	SvrPort          int     `json:"svr_port"`
+	MaxCpu           float64 `json:"max_cpu"`
+	MinCpu           float64 `json:"min_cpu"`
+	MemorySize       int64   `json:"memory_size"`
+	LogDiskSize      int64   `json:"log_disk_size"`
+}
+
+func (z *ZoneInfo) IsActive() bool {
+	return z.Status == ZONE_STATUS_ACTIVE
+}
+
+// ActiveServers returns the servers which are ACTIVE.
+func (z *ZoneInfo) ActiveServers() []*ZoneServer {
+	servers := make([]*ZoneServer, 0)
+	for _, server := range z.Servers {
+		if server.Status == SERVER_STATUS_ACTIVE {
+			servers = append(servers, server)
+		}
+	}
+	return servers
+}
+
+// Tenants returns the names of the tenants which have units on the zone.
+func (z *ZoneInfo) Tenants() []string {
+	tenants := make([]string, 0)
+	for _, unit := range z.Units {
+		if !containsString(tenants, unit.TenantName) {
+			tenants = append(tenants, unit.TenantName)
+		}
+	}
+	return tenants
+}
+
+// CanHostUnits returns true if the zone is active and has at least unitNum active servers,
+// which means unitNum units of a resource pool can be placed on it.
+func (z *ZoneInfo) CanHostUnits(unitNum int) bool {
+	return z.IsActive() && len(z.ActiveServers()) >= unitNum
+}
@@ -0,0 +1,282 @@
+/*
+ * Copyright (c) 2024 OceanBase.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sdk
+
+import (
+	"encoding/json"
+	"io"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/oceanbase/obshell-sdk-go/log"
+	"github.com/oceanbase/obshell-sdk-go/model"
+	"github.com/oceanbase/obshell-sdk-go/sdk/option"
+	"github.com/oceanbase/obshell-sdk-go/sdk/request"
+	responselib "github.com/oceanbase/obshell-sdk-go/sdk/response"
+)
+
+const (
+	AUDIT_EVENT_REQUEST  = "REQUEST"
+	AUDIT_EVENT_DAG_DONE = "DAG_DONE"
+
+	REDACTED = "******"
+)
+
+// The body fields whose name contains any of the keywords will be redacted in the audit record.
+var AuditRedactKeywords = []string{"password", "passwd", "pwd", "secret", "token", "credential", "private_key", "access_key", "encrypt", "decrypt", "kms"}
+
+// The query parameters of the URI values(such as the backup destination 'oss://bucket/path?host=xxx&access_id=xxx&access_key=xxx')
+// whose name is one of AuditRedactUriParams or contains any of AuditRedactKeywords will be redacted in the audit record.
+var AuditRedactUriParams = []string{"access_id", "access_key", "host"}
+
+// AuditRecord is the record of a mutating request.
+// For the request which creates a task, another record with event AUDIT_EVENT_DAG_DONE
+// will be written when the task is finished and waited by the client.
+type AuditRecord struct {
+	Event     string      `json:"event"`
+	Timestamp time.Time   `json:"timestamp"`
+	Caller    string      `json:"caller"`
+	Server    string      `json:"server"`
+	Method    string      `json:"method,omitempty"`
+	Uri       string      `json:"uri,omitempty"`
+	Body      interface{} `json:"body,omitempty"`
+	DagID     string      `json:"dag_id,omitempty"`
+	State     string      `json:"state,omitempty"`
+	Error     string      `json:"error,omitempty"`
+}
+
+// AuditSink receives the audit records of the client.
+type AuditSink interface {
+	WriteAuditRecord(record *AuditRecord) error
+}
+
+// WriterAuditSink writes the audit records to an io.Writer in JSON lines format.
+// It is safe to share a WriterAuditSink between clients.
+type WriterAuditSink struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+func NewWriterAuditSink(w io.Writer) *WriterAuditSink {
+	return &WriterAuditSink{w: w}
+}
+
+func (s *WriterAuditSink) WriteAuditRecord(record *AuditRecord) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, err = s.w.Write(append(data, '\n'))
+	return err
+}
+
+// FileAuditSink appends the audit records to a file in JSON lines format.
+type FileAuditSink struct {
+	*WriterAuditSink
+	file *os.File
+}
+
+// NewFileAuditSink opens the file in append mode, the file will be created if it does not exist.
+// You need to call Close when the sink is no longer used.
+func NewFileAuditSink(path string) (*FileAuditSink, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0640)
+	if err != nil {
+		return nil, err
+	}
+	return &FileAuditSink{
+		WriterAuditSink: NewWriterAuditSink(file),
+		file:            file,
+	}, nil
+}
+
+func (s *FileAuditSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.file.Close()
+}
+
+// WithAuditSink returns an option to record all the mutating requests(POST, PUT, PATCH and DELETE) to the sink.
+func WithAuditSink(sink AuditSink) *option.BaseOption {
+	return option.NewBaseOption("audit", option.AUDIT_OPT, sink)
+}
+
+// WithCaller returns an option to set the caller identity in the audit records.
+func WithCaller(caller string) *option.BaseOption {
+	return option.NewBaseOption("caller", option.CALLER_OPT, caller)
+}
+
+func (c *Client) GetCaller() string {
+	return c.caller
+}
+
+// SetCaller sets the caller identity in the audit records,
+// for the cluster client it is also set to the client of every agent.
+func (c *Client) SetCaller(caller string) {
+	c.caller = caller
+	if c.router != nil {
+		c.router.setCaller(caller)
+	}
+}
+
+// SetAuditSink sets the audit sink, nil disables the audit.
+// For the cluster client it is also set to the client of every agent.
+func (c *Client) SetAuditSink(sink AuditSink) {
+	c.auditSink = sink
+	if c.router != nil {
+		c.router.setAuditSink(sink)
+	}
+}
+
+func isMutatingMethod(method string) bool {
+	switch method {
+	case "POST", "PUT", "PATCH", "DELETE":
+		return true
+	}
+	return false
+}
+
+func (c *Client) newAuditRecord(event string) *AuditRecord {
+	return &AuditRecord{
+		Event:     event,
+		Timestamp: time.Now(),
+		Caller:    c.caller,
+		Server:    c.GetServer(),
+	}
+}
+
+func (c *Client) writeAuditRecord(record *AuditRecord) {
+	if err := c.auditSink.WriteAuditRecord(record); err != nil {
+		log.Warnf("write audit record failed: %v", err)
+	}
+}
+
+func (c *Client) auditRequest(req request.Request, body interface{}, response responselib.Response, err error) {
+	record := c.newAuditRecord(AUDIT_EVENT_REQUEST)
+	record.Server = req.GetServer()
+	record.Method = req.GetMethod()
+	record.Uri, _ = req.GetUri()
+	record.Body = RedactBody(body)
+	if err != nil {
+		record.Error = err.Error()
+	} else if response != nil {
+		if dag, ok := response.GetData().(*model.DagDetailDTO); ok && dag.GenericDTO != nil {
+			record.DagID = dag.GenericID
+			if dag.DagDetail != nil {
+				record.State = dag.State
+			}
+		}
+	}
+	c.writeAuditRecord(record)
+}
+
+// AuditDag records the final state of the task, it is called when the task is waited to be finished.
+// It does nothing if the audit sink is not set.
+func (c *Client) AuditDag(dagID string, dag *model.DagDetailDTO, err error) {
+	if c.auditSink == nil {
+		return
+	}
+	record := c.newAuditRecord(AUDIT_EVENT_DAG_DONE)
+	record.DagID = dagID
+	if dag != nil && dag.DagDetail != nil {
+		record.State = dag.State
+	}
+	if err != nil {
+		record.Error = err.Error()
+	}
+	c.writeAuditRecord(record)
+}
+
+// RedactBody returns a copy of the body as generic json value,
+// in which the value of the sensitive fields and the sensitive query parameters of the URIs are replaced with REDACTED.
+func RedactBody(body interface{}) interface{} {
+	if body == nil {
+		return nil
+	}
+	data, err := json.Marshal(body)
+	if err != nil {
+		return nil
+	}
+	var value interface{}
+	if err = json.Unmarshal(data, &value); err != nil {
+		return nil
+	}
+	return redactValue(value)
+}
+
+func redactValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, item := range v {
+			if isSensitiveKey(key) {
+				v[key] = REDACTED
+			} else {
+				v[key] = redactValue(item)
+			}
+		}
+	case []interface{}:
+		for i, item := range v {
+			v[i] = redactValue(item)
+		}
+	case string:
+		return redactUri(v)
+	}
+	return value
+}
+
+// redactUri redacts the sensitive query parameters of the value if it is a URI, the other parts are kept as is.
+// Only the part before the query is parsed, so that a malformed query does not leak the parameters.
+func redactUri(value string) string {
+	index := strings.Index(value, "?")
+	if index == -1 {
+		return value
+	}
+	if u, err := url.Parse(value[:index]); err != nil || u.Scheme == "" {
+		return value
+	}
+	params := strings.Split(value[index+1:], "&")
+	for i, param := range params {
+		key := strings.SplitN(param, "=", 2)[0]
+		if isSensitiveUriParam(key) {
+			params[i] = key + "=" + REDACTED
+		}
+	}
+	return value[:index+1] + strings.Join(params, "&")
+}
+
+func isSensitiveUriParam(key string) bool {
+	for _, param := range AuditRedactUriParams {
+		if strings.EqualFold(key, param) {
+			return true
+		}
+	}
+	return isSensitiveKey(key)
+}
+
+func isSensitiveKey(key string) bool {
+	key = strings.ToLower(key)
+	for _, keyword := range AuditRedactKeywords {
+		if strings.Contains(key, keyword) {
+			return true
+		}
+	}
+	return false
+}
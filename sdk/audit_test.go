@@ -0,0 +1,78 @@
+/*
+ * Copyright (c) 2024 OceanBase.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sdk_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/oceanbase/obshell-sdk-go/sdk"
+	v1 "github.com/oceanbase/obshell-sdk-go/services/v1"
+)
+
+const (
+	testAccessId  = "test-access-id"
+	testAccessKey = "test-access-key"
+	testHost      = "oss-cn-hangzhou.aliyuncs.com"
+	testSecret    = "test-secret"
+)
+
+var testUri = "oss://bucket/backup?host=" + testHost + "&access_id=" + testAccessId + "&access_key=" + testAccessKey
+
+func checkRedacted(t *testing.T, body interface{}) {
+	data, err := json.Marshal(sdk.RedactBody(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, secret := range []string{testAccessId, testAccessKey, testHost, testSecret} {
+		if strings.Contains(string(data), secret) {
+			t.Errorf("%s is not redacted: %s", secret, data)
+		}
+	}
+	if !strings.Contains(string(data), "oss://bucket/backup?") {
+		t.Errorf("the uri is not kept: %s", data)
+	}
+}
+
+func TestRedactBackupBody(t *testing.T) {
+	secret := testSecret
+	backup := &v1.ClusterBackupApiParam{}
+	backup.Encryption = &secret
+	data, err := json.Marshal(sdk.RedactBody(backup))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(data), testSecret) {
+		t.Errorf("encryption is not redacted: %s", data)
+	}
+
+	checkRedacted(t, &v1.ClusterBackupConfigApiParam{BackupBaseUri: testUri})
+}
+
+func TestRedactRestoreBody(t *testing.T) {
+	secret := testSecret
+	archiveLogUri := testUri
+	checkRedacted(t, &v1.RestoreApiParam{
+		DataBackupUri:        testUri,
+		ArchiveLogUri:        &archiveLogUri,
+		TenantName:           "t1",
+		FullBackupDecryption: &secret,
+		IncBackupDecryption:  &secret,
+		KmsEncryptInfo:       &secret,
+	})
+}
@@ -82,6 +82,12 @@ type Auther interface {
 	Auth(request request.Request, context *request.Context) error
 }
 
+// Cloner is implemented by the Auther which can be copied,
+// the copy has the same credential but no authentication state.
+type Cloner interface {
+	Clone() Auther
+}
+
 // AuthVersion implements Versioner
 type AuthVersion struct {
 	version           string
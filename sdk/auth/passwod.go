@@ -40,6 +40,16 @@ func NewPasswordAuth(pwd string) *PasswordAuth {
 	}
 }
 
+// Clone returns a PasswordAuth with the same password, lifetime and specified version.
+func (auth *PasswordAuth) Clone() Auther {
+	clone := NewPasswordAuth(auth.pwd)
+	clone.letftime = auth.letftime
+	if !auth.IsAutoSelectVersion() {
+		clone.SetVersion(auth.GetVersion())
+	}
+	return clone
+}
+
 func (auth *PasswordAuth) SetLifetime(lifetime time.Duration) {
 	auth.letftime = lifetime
 	auth.method = nil
@@ -19,6 +19,7 @@ package sdk
 import (
 	"fmt"
 	"reflect"
+	"time"
 
 	"github.com/go-resty/resty/v2"
 	"github.com/pkg/errors"
@@ -38,6 +39,14 @@ type Client struct {
 
 	auth          auth.Auther
 	candidateAuth auth.Auther
+
+	caller    string
+	auditSink AuditSink
+
+	agentVersion string        // Cached by GetAgentVersion.
+	timeout      time.Duration // The timeout of every request, 0 means no timeout.
+
+	router *clusterRouter // Only set for the cluster client.
 }
 
 // NewClient creates a new client with the given host and port.
@@ -56,6 +65,10 @@ func NewClient(host string, port int, options ...option.Optioner) (c *Client, er
 		switch opt.Type() {
 		case option.AUTH_OPT:
 			c.auth = opt.Value().(auth.Auther)
+		case option.AUDIT_OPT:
+			c.auditSink = opt.Value().(AuditSink)
+		case option.CALLER_OPT:
+			c.caller = opt.Value().(string)
 		}
 	}
 	return
@@ -75,6 +88,33 @@ func NewClientWithPassword(host string, port int, password string) (c *Client, e
 	return NewClient(host, port, WithPasswordAuth(password))
 }
 
+// NewAgentClient creates a client of another agent with the options of the client.
+// The auth is copied so that the auth state is kept per agent, the caller, the audit sink and the timeout are shared.
+func (c *Client) NewAgentClient(host string, port int) (*Client, error) {
+	client, err := NewClientWithServer(host, port)
+	if err != nil {
+		return nil, err
+	}
+	client.auth = cloneAuth(c.auth)
+	client.caller = c.caller
+	client.auditSink = c.auditSink
+	client.SetTimeout(c.timeout)
+	return client, nil
+}
+
+// SetTimeout sets the timeout of every request sent by the client, 0 means no timeout.
+// For the cluster client it is also set to the client of every agent.
+func (c *Client) SetTimeout(timeout time.Duration) {
+	c.timeout = timeout
+	c.httpClient.SetTimeout(timeout)
+	if c.router != nil {
+		c.router.timeout = timeout
+		for _, client := range c.router.clients {
+			client.SetTimeout(timeout)
+		}
+	}
+}
+
 func (c *Client) GetServer() string {
 	return fmt.Sprintf("%s:%d", c.host, c.port)
 }
@@ -86,6 +126,9 @@ func (c *Client) GetAuth() auth.Auther {
 func (c *Client) SetAuth(auth auth.Auther) {
 	auth.ResetMethod()
 	c.setAuth(auth)
+	if c.router != nil {
+		c.router.setAuth(auth)
+	}
 }
 
 func (c *Client) setAuth(auth auth.Auther) {
@@ -102,6 +145,9 @@ func (c *Client) SetCandidateAuth(auth auth.Auther) {
 		}
 	}
 	c.candidateAuth = auth
+	if c.router != nil {
+		c.router.setCandidateAuth(auth)
+	}
 }
 
 func (c *Client) AdoptCandidateAuth() {
@@ -110,10 +156,18 @@ func (c *Client) AdoptCandidateAuth() {
 	}
 	c.auth = c.candidateAuth
 	c.candidateAuth = nil
+	if c.router != nil {
+		c.router.adoptCandidateAuth(c.auth)
+	}
 }
 
 func (c *Client) DiscardCandidateAuth() {
 	c.candidateAuth = nil
+	if c.router != nil {
+		for _, client := range c.router.clients {
+			client.DiscardCandidateAuth()
+		}
+	}
 }
 
 func (c *Client) GetHost() string { // MASTER or CLUSTER AGENT
@@ -125,10 +179,11 @@ func (c *Client) GetPort() int {
 }
 
 func (c *Client) confirmAuthVersion() error {
-	agentInfo, err := util.GetInfo(c.GetServer())
+	agentInfo, err := util.GetInfoWithTimeout(c.GetServer(), c.timeout)
 	if err != nil {
 		return errors.Wrap(err, "get version failed")
 	}
+	c.agentVersion = agentInfo.Version
 
 	if !c.auth.IsAutoSelectVersion() {
 		if !c.auth.IsSupported(c.auth.GetVersion()) {
@@ -171,9 +226,39 @@ func (c *Client) confirmAuthVersion() error {
 
 func (c *Client) reconfirmAuthVersion() error {
 	c.auth.Reset()
+	c.ResetAgentVersion()
 	return c.confirmAuthVersion()
 }
 
+// GetAgentVersion returns the version of the current agent. The version is cached until the auth version
+// is reconfirmed, the cluster client switches to another agent or ResetAgentVersion is called.
+func (c *Client) GetAgentVersion() (string, error) {
+	if c.agentVersion == "" {
+		info, err := util.GetInfoWithTimeout(c.GetServer(), DEFAULT_AGENT_PROBE_TIMEOUT)
+		if err != nil && c.router != nil {
+			// The current agent may be down, switch to another one like executeInCluster.
+			if err = c.RefreshTopology(); err == nil {
+				info, err = util.GetInfoWithTimeout(c.GetServer(), DEFAULT_AGENT_PROBE_TIMEOUT)
+			}
+		}
+		if err != nil {
+			return "", errors.Wrap(err, "get version failed")
+		}
+		c.agentVersion = info.Version
+	}
+	return c.agentVersion, nil
+}
+
+// ResetAgentVersion clears the cached agent version, such as after the agent is upgraded.
+func (c *Client) ResetAgentVersion() {
+	c.agentVersion = ""
+	if c.router != nil {
+		for _, client := range c.router.clients {
+			client.agentVersion = ""
+		}
+	}
+}
+
 func (c *Client) tryCandidateAuth(request request.Request, response responselib.Response) bool {
 	if c.candidateAuth == nil {
 		return false
@@ -205,7 +290,23 @@ func (c *Client) tryCandidateAuth(request request.Request, response responselib.
 	}
 }
 
+// Execute sends the request and parses the result into response.
+// If the audit sink is set, the mutating request(POST, PUT, PATCH and DELETE) will be recorded.
 func (c *Client) Execute(request request.Request, response responselib.Response) (err error) {
+	if c.router != nil {
+		return c.executeInCluster(request, response)
+	}
+	if c.auditSink == nil || request == nil || reflect.ValueOf(request).IsNil() || !isMutatingMethod(request.GetMethod()) {
+		return c.execute(request, response)
+	}
+	// Record the plain body, the encrypted one is only kept in the request context.
+	body := request.GetBody()
+	err = c.execute(request, response)
+	c.auditRequest(request, body, response, err)
+	return err
+}
+
+func (c *Client) execute(request request.Request, response responselib.Response) (err error) {
 	if c.auth.GetVersion() == "" {
 		if err = c.confirmAuthVersion(); err != nil {
 			return err
@@ -0,0 +1,374 @@
+/*
+ * Copyright (c) 2024 OceanBase.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sdk
+
+import (
+	"fmt"
+	"net"
+	"reflect"
+	"sort"
+	"syscall"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/oceanbase/obshell-sdk-go/internal/util"
+	"github.com/oceanbase/obshell-sdk-go/model"
+	"github.com/oceanbase/obshell-sdk-go/sdk/auth"
+	"github.com/oceanbase/obshell-sdk-go/sdk/option"
+	"github.com/oceanbase/obshell-sdk-go/sdk/request"
+	responselib "github.com/oceanbase/obshell-sdk-go/sdk/response"
+)
+
+const (
+	DEFAULT_TOPOLOGY_REFRESH_INTERVAL = time.Minute
+	DEFAULT_AGENT_PROBE_TIMEOUT       = 5 * time.Second // The timeout to query the info of an agent when refreshing the topology.
+)
+
+var ErrNoAvailableAgent = errors.New("no available agent")
+
+// retargetable is implemented by the request which can be sent to another agent, such as request.BaseRequest.
+type retargetable interface {
+	SetServer(host string, port int)
+}
+
+// clusterRouter routes the requests of a cluster client to the MASTER or CLUSTER AGENT,
+// and fails over to another agent on network errors.
+// Every agent has its own Client, so the auth state is kept per agent.
+type clusterRouter struct {
+	options         []option.Optioner
+	auth            auth.Auther
+	clients         map[string]*Client // server -> client
+	seeds           []string
+	agents          []string // All the agents of the cluster, the current one is the first.
+	refreshInterval time.Duration
+	lastRefresh     time.Time
+	timeout         time.Duration // The timeout of the clients of the agents.
+}
+
+// NewClusterClient creates a cluster-aware client seeded with one or more agents('ip:port').
+// It discovers all the agents of the cluster, routes the requests to the MASTER or CLUSTER AGENT,
+// and fails over to another agent when a network error occurs. The topology is refreshed every
+// DEFAULT_TOPOLOGY_REFRESH_INTERVAL, you can change it by SetTopologyRefreshInterval.
+//
+// The auth option must be cloneable(such as sdk.WithPasswordAuth), so that every agent has its own auth state.
+//
+// AS: sdk.NewClusterClient([]string{"10.0.0.1:2886", "10.0.0.2:2886"}, sdk.WithPasswordAuth("password"))
+func NewClusterClient(seeds []string, options ...option.Optioner) (*Client, error) {
+	if len(seeds) == 0 {
+		return nil, errors.New("no seed agent")
+	}
+	first, err := util.ParseAddr(seeds[0])
+	if err != nil {
+		return nil, err
+	}
+	c, err := NewClient(first.Ip, first.Port, options...)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := c.auth.(auth.Cloner); !ok {
+		return nil, errors.New("the auth of cluster client must be cloneable")
+	}
+	c.router = &clusterRouter{
+		options:         options,
+		auth:            c.auth,
+		clients:         make(map[string]*Client),
+		seeds:           seeds,
+		refreshInterval: DEFAULT_TOPOLOGY_REFRESH_INTERVAL,
+	}
+	if err = c.RefreshTopology(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// IsClusterClient returns true if the client is created by NewClusterClient.
+func (c *Client) IsClusterClient() bool {
+	return c.router != nil
+}
+
+// SetTopologyRefreshInterval sets the interval to refresh the topology of the cluster client.
+func (c *Client) SetTopologyRefreshInterval(interval time.Duration) {
+	if c.router != nil {
+		c.router.refreshInterval = interval
+	}
+}
+
+// GetAgents returns all the known agents of the cluster client, the current one is the first.
+func (c *Client) GetAgents() []string {
+	if c.router == nil {
+		return []string{c.GetServer()}
+	}
+	return append([]string{}, c.router.agents...)
+}
+
+// RefreshTopology discovers the agents of the cluster and selects the MASTER or CLUSTER AGENT as the current one.
+// It does nothing if the client is not a cluster client.
+func (c *Client) RefreshTopology() error {
+	r := c.router
+	if r == nil {
+		return nil
+	}
+
+	known := append(append([]string{}, r.agents...), r.seeds...)
+	candidates := make([]string, 0)
+	others := make([]string, 0)
+	var lastErr error
+	for _, server := range uniqueStrings(known) {
+		info, err := util.GetInfoWithTimeout(server, DEFAULT_AGENT_PROBE_TIMEOUT)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		if info.Identity == model.MASTER || info.Identity == model.CLUSTER_AGENT {
+			candidates = append(candidates, server)
+		} else {
+			others = append(others, server)
+		}
+	}
+	if len(candidates) == 0 && len(others) == 0 {
+		return errors.Wrapf(ErrNoAvailableAgent, "last error: %v", lastErr)
+	}
+
+	// Discover the other agents through the first available one.
+	discovered := make([]string, 0)
+	for _, server := range append(candidates, others...) {
+		agents, err := r.discover(server)
+		if err == nil {
+			discovered = agents
+			break
+		}
+		lastErr = err
+	}
+	for _, server := range discovered {
+		if containsAgent(candidates, server) || containsAgent(others, server) {
+			continue
+		}
+		if info, err := util.GetInfoWithTimeout(server, DEFAULT_AGENT_PROBE_TIMEOUT); err == nil && (info.Identity == model.MASTER || info.Identity == model.CLUSTER_AGENT) {
+			candidates = append(candidates, server)
+		} else {
+			others = append(others, server)
+		}
+	}
+
+	// Keep the current agent first if it is still a candidate, to avoid switching needlessly.
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i] == c.GetServer() && candidates[j] != c.GetServer()
+	})
+	r.agents = append(candidates, others...)
+	r.lastRefresh = time.Now()
+	return c.switchTo(r.agents[0])
+}
+
+// discover returns all the agents of the cluster through the server.
+func (r *clusterRouter) discover(server string) ([]string, error) {
+	client, err := r.getClient(server)
+	if err != nil {
+		return nil, err
+	}
+	req := request.NewBaseRequest()
+	req.InitApiInfo("/api/v1/ob/info", client.GetHost(), client.GetPort(), "GET")
+	req.SetAuthentication()
+	obInfo := &model.ObInfoResp{}
+	resp := responselib.NewOcsAgentResponse()
+	resp.Data = obInfo
+	if err = client.Execute(req, resp); err != nil {
+		return nil, err
+	}
+	agents := make([]string, 0, len(obInfo.Agents))
+	for _, agent := range obInfo.Agents {
+		agents = append(agents, agent.String())
+	}
+	return agents, nil
+}
+
+func (r *clusterRouter) getClient(server string) (*Client, error) {
+	if client, ok := r.clients[server]; ok {
+		return client, nil
+	}
+	agent, err := util.ParseAddr(server)
+	if err != nil {
+		return nil, err
+	}
+	options := make([]option.Optioner, 0, len(r.options))
+	for _, opt := range r.options {
+		if opt.Type() != option.AUTH_OPT {
+			options = append(options, opt)
+		}
+	}
+	client, err := NewClient(agent.Ip, agent.Port, options...)
+	if err != nil {
+		return nil, err
+	}
+	client.auth = cloneAuth(r.auth)
+	client.SetTimeout(r.timeout)
+	r.clients[server] = client
+	return client, nil
+}
+
+// cloneAuth returns a copy of the auth for an agent, the auth itself is returned if it is not cloneable.
+func cloneAuth(a auth.Auther) auth.Auther {
+	if cloner, ok := a.(auth.Cloner); ok {
+		return cloner.Clone()
+	}
+	return a
+}
+
+func (r *clusterRouter) setAuth(a auth.Auther) {
+	r.auth = a
+	for _, client := range r.clients {
+		client.SetAuth(cloneAuth(a))
+	}
+}
+
+// setOption replaces the option of the same type for the agents created later, nil removes it.
+func (r *clusterRouter) setOption(optType option.OptionType, opt option.Optioner) {
+	options := make([]option.Optioner, 0, len(r.options)+1)
+	for _, o := range r.options {
+		if o.Type() != optType {
+			options = append(options, o)
+		}
+	}
+	if opt != nil {
+		options = append(options, opt)
+	}
+	r.options = options
+}
+
+func (r *clusterRouter) setCaller(caller string) {
+	r.setOption(option.CALLER_OPT, WithCaller(caller))
+	for _, client := range r.clients {
+		client.SetCaller(caller)
+	}
+}
+
+func (r *clusterRouter) setAuditSink(sink AuditSink) {
+	if sink == nil {
+		r.setOption(option.AUDIT_OPT, nil)
+	} else {
+		r.setOption(option.AUDIT_OPT, WithAuditSink(sink))
+	}
+	for _, client := range r.clients {
+		client.SetAuditSink(sink)
+	}
+}
+
+func (r *clusterRouter) setCandidateAuth(a auth.Auther) {
+	for _, client := range r.clients {
+		client.SetCandidateAuth(cloneAuth(a))
+	}
+}
+
+// adoptCandidateAuth adopts the candidate auth of every agent,
+// the agents which have never tried the candidate auth will use a copy of a.
+func (r *clusterRouter) adoptCandidateAuth(a auth.Auther) {
+	r.auth = a
+	for _, client := range r.clients {
+		if client.candidateAuth != nil {
+			client.AdoptCandidateAuth()
+		} else {
+			client.setAuth(cloneAuth(a))
+		}
+	}
+}
+
+func (c *Client) switchTo(server string) error {
+	agent, err := util.ParseAddr(server)
+	if err != nil {
+		return err
+	}
+	if c.host != agent.Ip || c.port != agent.Port {
+		c.agentVersion = "" // The agents may be on different versions.
+	}
+	c.host = agent.Ip
+	c.port = agent.Port
+	return nil
+}
+
+// executeInCluster sends the request to the current agent, and fails over to the other agents on network errors.
+// Only GET requests are resent after the request may have reached the agent, the other requests fail over
+// only when the connection can not be established, so that a mutating operation is never executed twice.
+func (c *Client) executeInCluster(req request.Request, response responselib.Response) (err error) {
+	if req == nil || reflect.ValueOf(req).IsNil() {
+		return errors.New("request is nil")
+	}
+	r := c.router
+	if time.Since(r.lastRefresh) > r.refreshInterval {
+		if err = c.RefreshTopology(); err != nil {
+			return err
+		}
+	}
+
+	for _, server := range append([]string{}, r.agents...) {
+		client, err := r.getClient(server)
+		if err != nil {
+			return err
+		}
+		if target, ok := req.(retargetable); ok {
+			target.SetServer(client.GetHost(), client.GetPort())
+		} else if req.GetServer() != server {
+			return fmt.Errorf("request can not be sent to %s", server)
+		}
+
+		if err = client.Execute(req, response); err == nil || !canFailover(req, err) {
+			if server != c.GetServer() {
+				c.switchTo(server)
+			}
+			return err
+		}
+		// Refresh the topology at the next request, the master may be changed.
+		r.lastRefresh = time.Time{}
+	}
+	return errors.Wrap(ErrNoAvailableAgent, fmt.Sprintf("all the agents %v are unreachable", r.agents))
+}
+
+// canFailover returns true if the request can be resent to another agent after err.
+func canFailover(req request.Request, err error) bool {
+	if req.GetMethod() == "GET" {
+		var netErr net.Error
+		return errors.As(err, &netErr)
+	}
+	return isNotSentError(err)
+}
+
+// isNotSentError returns true if err proves that the request has never left the client.
+func isNotSentError(err error) bool {
+	var opErr *net.OpError
+	if errors.As(err, &opErr) && opErr.Op == "dial" {
+		return true
+	}
+	return errors.Is(err, syscall.ECONNREFUSED)
+}
+
+func containsAgent(agents []string, server string) bool {
+	for _, agent := range agents {
+		if agent == server {
+			return true
+		}
+	}
+	return false
+}
+
+func uniqueStrings(list []string) []string {
+	result := make([]string, 0, len(list))
+	for _, item := range list {
+		if !containsAgent(result, item) {
+			result = append(result, item)
+		}
+	}
+	return result
+}
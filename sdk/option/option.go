@@ -20,6 +20,9 @@ type OptionType int
 
 const (
 	AUTH_OPT OptionType = iota + 1
+	GUARDRAIL_OPT
+	AUDIT_OPT
+	CALLER_OPT
 )
 
 type Optioner interface {
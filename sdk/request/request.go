@@ -102,6 +102,12 @@ func (r *BaseRequest) GetPort() int {
 	return r.port
 }
 
+// SetServer changes the target of the request, it is used to fail over to another agent.
+func (r *BaseRequest) SetServer(host string, port int) {
+	r.host = host
+	r.port = port
+}
+
 func (r *BaseRequest) BuildUrl() (string, error) {
 	uri, err := r.GetUri()
 	if err != nil {
@@ -27,6 +27,15 @@ type Clientset struct {
 	clientV2 *v2.Client
 }
 
+// newClientset builds the v2 client on the sdk.Client of the v1 client,
+// so that both versions share the auth, the routing and the audit settings.
+func newClientset(clientV1 *v1.Client) *Clientset {
+	return &Clientset{
+		clientV1: clientV1,
+		clientV2: v2.NewClientWithSdk(clientV1.Client),
+	}
+}
+
 // NewClient creates a new client with the given host and port.
 //
 // The client will use the given options to configure the client.
@@ -38,9 +47,7 @@ func NewClient(host string, port int, options ...option.Optioner) (*Clientset, e
 	if err != nil {
 		return nil, err
 	}
-	return &Clientset{
-		clientV1: clientV1,
-	}, nil
+	return newClientset(clientV1), nil
 }
 
 func NewClientWithServer(host string, port int) (*Clientset, error) {
@@ -48,9 +55,7 @@ func NewClientWithServer(host string, port int) (*Clientset, error) {
 	if err != nil {
 		return nil, err
 	}
-	return &Clientset{
-		clientV1: clientV1,
-	}, nil
+	return newClientset(clientV1), nil
 }
 
 func NewClientWithPassword(host string, port int, password string) (*Clientset, error) {
@@ -58,15 +63,24 @@ func NewClientWithPassword(host string, port int, password string) (*Clientset,
 	if err != nil {
 		return nil, err
 	}
-	return &Clientset{
-		clientV1: clientV1,
-	}, nil
+	return newClientset(clientV1), nil
+}
+
+// NewClusterClient creates a cluster-aware client seeded with one or more agents('ip:port').
+// See sdk.NewClusterClient for details.
+func NewClusterClient(seeds []string, options ...option.Optioner) (*Clientset, error) {
+	clientV1, err := v1.NewClusterClient(seeds, options...)
+	if err != nil {
+		return nil, err
+	}
+	return newClientset(clientV1), nil
 }
 
 func (c *Clientset) V1() *v1.Client {
 	return c.clientV1
 }
 
+// V2 returns the v2 client, which shares the underlying sdk.Client with V1.
 func (c *Clientset) V2() *v2.Client {
 	return c.clientV2
 }
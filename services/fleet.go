@@ -0,0 +1,422 @@
+/*
+ * Copyright (c) 2024 OceanBase.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package services
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/oceanbase/obshell-sdk-go/internal/util"
+	"github.com/oceanbase/obshell-sdk-go/sdk"
+	"github.com/oceanbase/obshell-sdk-go/sdk/option"
+)
+
+const DEFAULT_FLEET_CONCURRENCY = 8
+
+var (
+	ErrClusterNotFound = errors.New("cluster not found in fleet")
+	ErrRolloutSkipped  = errors.New("skipped because the rollout is stopped")
+)
+
+// FleetClusterConfig is the config of a cluster in the fleet.
+type FleetClusterConfig struct {
+	Name string `json:"name"`
+	// Endpoints are the agents('ip:port') of the cluster. When there are more than one endpoints,
+	// a cluster-aware client will be created, see NewClusterClient.
+	Endpoints []string `json:"endpoints"`
+	// Password is the root password of the sys tenant, PasswordEnv is the name of the environment variable
+	// which holds the password. PasswordEnv is preferred if both are set.
+	Password    string            `json:"password,omitempty"`
+	PasswordEnv string            `json:"password_env,omitempty"`
+	Labels      map[string]string `json:"labels,omitempty"`
+}
+
+// FleetConfig is the config of a fleet, it can be loaded from a json file by LoadFleetConfig.
+type FleetConfig struct {
+	Concurrency int                  `json:"concurrency"` // The max number of clusters operated at the same time, default is DEFAULT_FLEET_CONCURRENCY.
+	Clusters    []FleetClusterConfig `json:"clusters"`
+}
+
+// LoadFleetConfig loads the FleetConfig from a json file, such as:
+//
+//	{
+//	  "concurrency": 4,
+//	  "clusters": [
+//	    {"name": "prod-1", "endpoints": ["10.0.0.1:2886", "10.0.0.2:2886"], "password_env": "PROD_1_PASSWORD", "labels": {"env": "prod"}}
+//	  ]
+//	}
+func LoadFleetConfig(path string) (*FleetConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	config := &FleetConfig{}
+	if err = json.Unmarshal(data, config); err != nil {
+		return nil, errors.Wrapf(err, "parse fleet config %s", path)
+	}
+	return config, nil
+}
+
+// Fleet holds the clients of many clusters and runs operations across them.
+// The client of a cluster is created at the first time it is used, so an unreachable cluster
+// only fails the operations on itself. Fleet is thread-safe.
+type Fleet struct {
+	concurrency int
+	mu          sync.RWMutex // Guards names and clusters.
+	names       []string
+	clusters    map[string]*fleetCluster
+}
+
+type fleetCluster struct {
+	config FleetClusterConfig
+	// mu serializes the creation and the operations of the clientset, as the client is not thread-safe.
+	mu        sync.Mutex
+	clientset *Clientset
+}
+
+// NewFleetFromFile creates a Fleet from a json config file, see LoadFleetConfig.
+func NewFleetFromFile(path string) (*Fleet, error) {
+	config, err := LoadFleetConfig(path)
+	if err != nil {
+		return nil, err
+	}
+	return NewFleet(config)
+}
+
+// NewFleet creates a Fleet of all the clusters in the config, the config is validated without connecting to the clusters.
+func NewFleet(config *FleetConfig) (*Fleet, error) {
+	fleet := &Fleet{
+		concurrency: config.Concurrency,
+		clusters:    make(map[string]*fleetCluster),
+	}
+	if fleet.concurrency <= 0 {
+		fleet.concurrency = DEFAULT_FLEET_CONCURRENCY
+	}
+	for _, cluster := range config.Clusters {
+		if err := fleet.Add(cluster); err != nil {
+			return nil, err
+		}
+	}
+	return fleet, nil
+}
+
+// Add adds the cluster into the fleet, the client of the cluster is created when it is used.
+func (f *Fleet) Add(config FleetClusterConfig) error {
+	if config.Name == "" {
+		return errors.New("cluster name is empty")
+	}
+	if len(config.Endpoints) == 0 {
+		return fmt.Errorf("cluster '%s' has no endpoint", config.Name)
+	}
+	for _, endpoint := range config.Endpoints {
+		if _, err := util.ParseAddr(endpoint); err != nil {
+			return errors.Wrapf(err, "cluster '%s'", config.Name)
+		}
+	}
+
+	if _, err := config.getPassword(); err != nil {
+		return err
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if _, ok := f.clusters[config.Name]; ok {
+		return fmt.Errorf("cluster '%s' is duplicated", config.Name)
+	}
+	f.clusters[config.Name] = &fleetCluster{config: config}
+	f.names = append(f.names, config.Name)
+	sort.Strings(f.names)
+	return nil
+}
+
+// getPassword returns the password of the cluster, it is an error if the environment variable PasswordEnv is not set.
+func (c *FleetClusterConfig) getPassword() (string, error) {
+	if c.PasswordEnv == "" {
+		return c.Password, nil
+	}
+	password, ok := os.LookupEnv(c.PasswordEnv)
+	if !ok {
+		return "", fmt.Errorf("environment variable '%s' for the password of cluster '%s' is not set", c.PasswordEnv, c.Name)
+	}
+	return password, nil
+}
+
+func (c *fleetCluster) getClientset() (*Clientset, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.getClientsetLocked()
+}
+
+// run runs fn on the cluster, the cluster is locked until fn returns,
+// so that the clientset is never used by two operations at the same time.
+func (c *fleetCluster) run(fn FleetFunc) (interface{}, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	clientset, err := c.getClientsetLocked()
+	if err != nil {
+		return nil, err
+	}
+	return fn(c.config.Name, clientset)
+}
+
+// getClientsetLocked returns the client of the cluster, the client is created if it has not been created.
+// The creation will be retried at the next time if it fails. c.mu must be held.
+func (c *fleetCluster) getClientsetLocked() (*Clientset, error) {
+	if c.clientset != nil {
+		return c.clientset, nil
+	}
+
+	password, err := c.config.getPassword()
+	if err != nil {
+		return nil, err
+	}
+	options := []option.Optioner{sdk.WithPasswordAuth(password)}
+
+	var clientset *Clientset
+	if len(c.config.Endpoints) == 1 {
+		agent, _ := util.ParseAddr(c.config.Endpoints[0]) // Validated by Add.
+		clientset, err = NewClient(agent.Ip, agent.Port, options...)
+	} else {
+		clientset, err = NewClusterClient(c.config.Endpoints, options...)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "create client of cluster '%s'", c.config.Name)
+	}
+	c.clientset = clientset
+	return clientset, nil
+}
+
+// Names returns the names of all the clusters in alphabetical order.
+func (f *Fleet) Names() []string {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	return append([]string{}, f.names...)
+}
+
+func (f *Fleet) getCluster(name string) (*fleetCluster, error) {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	cluster, ok := f.clusters[name]
+	if !ok {
+		return nil, errors.Wrap(ErrClusterNotFound, name)
+	}
+	return cluster, nil
+}
+
+// Get returns the client of the cluster, the client is created at the first time.
+// The client is not thread-safe, it must not be used while an operation of ForEach or Rollout is running on the cluster.
+func (f *Fleet) Get(name string) (*Clientset, error) {
+	cluster, err := f.getCluster(name)
+	if err != nil {
+		return nil, err
+	}
+	return cluster.getClientset()
+}
+
+// SelectByLabel returns the names of the clusters whose label key equals to value.
+func (f *Fleet) SelectByLabel(key, value string) []string {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	names := make([]string, 0)
+	for _, name := range f.names {
+		if v, ok := f.clusters[name].config.Labels[key]; ok && v == value {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// FleetResult is the result of an operation on a cluster.
+type FleetResult struct {
+	Cluster string
+	Value   interface{}
+	Err     error
+}
+
+// FleetResults are the results of an operation on the clusters, in the order of the given names.
+type FleetResults []FleetResult
+
+// Errors returns the errors keyed by the cluster name.
+func (r FleetResults) Errors() map[string]error {
+	errs := make(map[string]error)
+	for _, result := range r {
+		if result.Err != nil {
+			errs[result.Cluster] = result.Err
+		}
+	}
+	return errs
+}
+
+// Succeeded returns the names of the clusters on which the operation is succeeded.
+func (r FleetResults) Succeeded() []string {
+	names := make([]string, 0)
+	for _, result := range r {
+		if result.Err == nil {
+			names = append(names, result.Cluster)
+		}
+	}
+	return names
+}
+
+// Values returns the values of the succeeded clusters keyed by the cluster name.
+func (r FleetResults) Values() map[string]interface{} {
+	values := make(map[string]interface{})
+	for _, result := range r {
+		if result.Err == nil {
+			values[result.Cluster] = result.Value
+		}
+	}
+	return values
+}
+
+// FleetFunc is the operation on a cluster.
+type FleetFunc func(name string, clientset *Clientset) (interface{}, error)
+
+// ForEach runs fn on the clusters concurrently, at most Concurrency clusters at the same time.
+// names: the clusters to be operated, all the clusters if it is empty. The duplicate names are ignored.
+// The operations on the same cluster, such as from the overlapped calls, are run one by one.
+func (f *Fleet) ForEach(names []string, fn FleetFunc) FleetResults {
+	return f.run(f.selectNames(names), fn, f.concurrency)
+}
+
+// selectNames returns all the clusters if names is empty, otherwise the names without duplicates.
+func (f *Fleet) selectNames(names []string) []string {
+	if len(names) == 0 {
+		return f.Names()
+	}
+	result := make([]string, 0, len(names))
+	seen := make(map[string]bool)
+	for _, name := range names {
+		if !seen[name] {
+			seen[name] = true
+			result = append(result, name)
+		}
+	}
+	return result
+}
+
+func (f *Fleet) run(names []string, fn FleetFunc, concurrency int) FleetResults {
+	results := make(FleetResults, len(names))
+	semaphore := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for i, name := range names {
+		wg.Add(1)
+		semaphore <- struct{}{}
+		go func(i int, name string) {
+			defer func() {
+				<-semaphore
+				wg.Done()
+			}()
+			cluster, err := f.getCluster(name)
+			if err != nil {
+				results[i] = FleetResult{Cluster: name, Err: err}
+				return
+			}
+			value, err := cluster.run(fn)
+			results[i] = FleetResult{Cluster: name, Value: value, Err: err}
+		}(i, name)
+	}
+	wg.Wait()
+	return results
+}
+
+// GetStatuses returns the AgentStatus of every cluster.
+func (f *Fleet) GetStatuses(names ...string) FleetResults {
+	return f.ForEach(names, func(name string, clientset *Clientset) (interface{}, error) {
+		return clientset.V1().GetStatus()
+	})
+}
+
+// GetTenants returns the []model.TenantOverview of every cluster.
+func (f *Fleet) GetTenants(names ...string) FleetResults {
+	return f.ForEach(names, func(name string, clientset *Clientset) (interface{}, error) {
+		return clientset.V1().GetAllTenantOverview()
+	})
+}
+
+// GetBackupOverviews returns the []model.CdbObBackupTask of every cluster.
+func (f *Fleet) GetBackupOverviews(names ...string) FleetResults {
+	return f.ForEach(names, func(name string, clientset *Clientset) (interface{}, error) {
+		return clientset.V1().GetClusterBackupOverview()
+	})
+}
+
+// RolloutOptions is the options of Rollout.
+type RolloutOptions struct {
+	CanarySize int           // The number of the clusters operated one by one at first, default is 1.
+	BatchSize  int           // The number of the clusters in a batch after the canaries, default is the concurrency of the fleet.
+	MaxFailure int           // The rollout stops when the number of the failed clusters is more than MaxFailure, default is 0.
+	Interval   time.Duration // The interval between the batches.
+	OnBatch    func(batch int, results FleetResults)
+}
+
+// Rollout runs a mutating operation across the clusters with canary-then-batch rollout.
+// The canaries are operated one by one, then the others are operated batch by batch concurrently.
+// Once the failed clusters are more than MaxFailure, the rollout stops and the remaining clusters
+// get ErrRolloutSkipped in the results.
+func (f *Fleet) Rollout(names []string, opts RolloutOptions, fn FleetFunc) FleetResults {
+	names = f.selectNames(names)
+	if opts.CanarySize <= 0 {
+		opts.CanarySize = 1
+	}
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = f.concurrency
+	}
+
+	batches := make([][]string, 0)
+	for i := 0; i < len(names) && i < opts.CanarySize; i++ {
+		batches = append(batches, names[i:i+1])
+	}
+	for i := opts.CanarySize; i < len(names); i += opts.BatchSize {
+		end := i + opts.BatchSize
+		if end > len(names) {
+			end = len(names)
+		}
+		batches = append(batches, names[i:end])
+	}
+
+	results := make(FleetResults, 0, len(names))
+	failures := 0
+	for i, batch := range batches {
+		if failures > opts.MaxFailure {
+			for _, name := range batch {
+				results = append(results, FleetResult{Cluster: name, Err: ErrRolloutSkipped})
+			}
+			continue
+		}
+		if i != 0 && opts.Interval > 0 {
+			time.Sleep(opts.Interval)
+		}
+		concurrency := len(batch)
+		if concurrency > f.concurrency {
+			concurrency = f.concurrency
+		}
+		batchResults := f.run(batch, fn, concurrency)
+		failures += len(batchResults.Errors())
+		if opts.OnBatch != nil {
+			opts.OnBatch(i, batchResults)
+		}
+		results = append(results, batchResults...)
+	}
+	return results
+}
@@ -0,0 +1,83 @@
+/*
+ * Copyright (c) 2024 OceanBase.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1
+
+import (
+	"fmt"
+
+	"github.com/oceanbase/obshell-sdk-go/model"
+	"github.com/oceanbase/obshell-sdk-go/sdk/request"
+	"github.com/oceanbase/obshell-sdk-go/sdk/response"
+)
+
+type ActivateStandbyTenantRequest struct {
+	*request.BaseRequest
+}
+
+type ActivateStandbyTenantResponse struct {
+	*response.TaskResponse
+}
+
+func (c *Client) createActivateStandbyTenantResponse() *ActivateStandbyTenantResponse {
+	return &ActivateStandbyTenantResponse{
+		TaskResponse: response.NewTaskResponse(),
+	}
+}
+
+// NewActivateStandbyTenantRequest return a ActivateStandbyTenantRequest, which can be used as the argument for the ActivateStandbyTenantWithRequest/ActivateStandbyTenantSyncWithRequest.
+// tenantName: the name of the standby tenant.
+// The standby tenant will be activated as primary immediately, without waiting for the remaining logs to be replayed.
+// Use FailoverTenant if you want to replay the logs available from the log source first.
+func (c *Client) NewActivateStandbyTenantRequest(tenantName string) *ActivateStandbyTenantRequest {
+	req := &ActivateStandbyTenantRequest{
+		BaseRequest: request.NewAsyncBaseRequest(),
+	}
+	req.SetAuthentication()
+	req.InitApiInfo(fmt.Sprintf("/api/v1/tenant/%s/activate", tenantName), c.GetHost(), c.GetPort(), "POST")
+	return req
+}
+
+// ActivateStandbyTenant returns a DagDetailDTO and an error, when the task is completed successfully, the error will be nil.
+// tenantName: the name of the standby tenant.
+func (c *Client) ActivateStandbyTenant(tenantName string) (*model.DagDetailDTO, error) {
+	request := c.NewActivateStandbyTenantRequest(tenantName)
+	return c.ActivateStandbyTenantSyncWithRequest(request)
+}
+
+// ActivateStandbyTenantWithRequest returns a DagDetailDTO and an error, when the task is requested successfully, the error will be nil.
+// the parameter is a ActivateStandbyTenantRequest, which can be created by NewActivateStandbyTenantRequest.
+// You can use WaitDagSucceed to wait for the task to complete.
+// You can check or operater the task through the DagDetailDTO.
+func (c *Client) ActivateStandbyTenantWithRequest(request *ActivateStandbyTenantRequest) (dag *model.DagDetailDTO, err error) {
+	response := c.createActivateStandbyTenantResponse()
+	if err = c.Execute(request, response); err != nil {
+		return nil, err
+	}
+	return response.DagDetailDTO, nil
+}
+
+// ActivateStandbyTenantSyncWithRequest returns a DagDetailDTO and an error, when the task is completed successfully, the error will be nil.
+// the DagDetailDTO is the final status of the task.
+// the parameter is a ActivateStandbyTenantRequest, which can be created by NewActivateStandbyTenantRequest.
+// You can check or operater the task through the DagDetailDTO.
+func (c *Client) ActivateStandbyTenantSyncWithRequest(request *ActivateStandbyTenantRequest) (*model.DagDetailDTO, error) {
+	dag, err := c.ActivateStandbyTenantWithRequest(request)
+	if err != nil {
+		return nil, err
+	}
+	return c.WaitDagSucceed(dag.GenericID)
+}
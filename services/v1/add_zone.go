@@ -0,0 +1,106 @@
+/*
+ * Copyright (c) 2024 OceanBase.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1
+
+import (
+	"github.com/oceanbase/obshell-sdk-go/model"
+	"github.com/oceanbase/obshell-sdk-go/sdk/request"
+	"github.com/oceanbase/obshell-sdk-go/sdk/response"
+)
+
+type AddZoneRequest struct {
+	*request.BaseRequest
+	param AddZoneParam
+}
+
+type AddZoneParam struct {
+	ZoneName string `json:"zone_name" binding:"required"`
+	Region   string `json:"region,omitempty"`
+	Idc      string `json:"idc,omitempty"`
+}
+
+type addZoneResponse struct {
+	*response.TaskResponse
+}
+
+func (c *Client) createAddZoneResponse() *addZoneResponse {
+	return &addZoneResponse{
+		TaskResponse: response.NewTaskResponse(),
+	}
+}
+
+// NewAddZoneRequest returns an AddZoneRequest, which can be used as the argument for the AddZoneWithRequest/AddZoneSyncWithRequest.
+// zoneName: the name of zone to be added.
+// You can use SetRegion and SetIdc to set the attributes of the zone.
+func (c *Client) NewAddZoneRequest(zoneName string) *AddZoneRequest {
+	req := &AddZoneRequest{
+		BaseRequest: request.NewAsyncBaseRequest(),
+		param: AddZoneParam{
+			ZoneName: zoneName,
+		},
+	}
+	req.SetBody(&req.param)
+	req.SetAuthentication()
+	req.InitApiInfo("/api/v1/zone", c.GetHost(), c.GetPort(), "POST")
+	return req
+}
+
+// SetRegion sets the region of the zone.
+func (r *AddZoneRequest) SetRegion(region string) *AddZoneRequest {
+	r.param.Region = region
+	r.SetBody(&r.param)
+	return r
+}
+
+// SetIdc sets the idc of the zone.
+func (r *AddZoneRequest) SetIdc(idc string) *AddZoneRequest {
+	r.param.Idc = idc
+	r.SetBody(&r.param)
+	return r
+}
+
+// AddZone adds an empty zone to cluster, the servers can be added into it by ScaleOut later.
+// zoneName: the name of zone to be added.
+// region: the region of the zone, optional.
+// idc: the idc of the zone, optional.
+func (c *Client) AddZone(zoneName string, region string, idc string) (*model.DagDetailDTO, error) {
+	request := c.NewAddZoneRequest(zoneName).SetRegion(region).SetIdc(idc)
+	return c.AddZoneSyncWithRequest(request)
+}
+
+// AddZoneWithRequest returns a DagDetailDTO and an error, when the task is requested successfully, the error will be nil.
+// You can use WaitDagSucceed to wait for the task to complete.
+// You can check or operater the task through the DagDetailDTO.
+func (c *Client) AddZoneWithRequest(request *AddZoneRequest) (dag *model.DagDetailDTO, err error) {
+	response := c.createAddZoneResponse()
+	if err = c.Execute(request, response); err != nil {
+		return nil, err
+	}
+	return response.DagDetailDTO, nil
+}
+
+// AddZoneSyncWithRequest returns a DagDetailDTO and an error, when the task is completed successfully, the error will be nil.
+// You can check or operater the task through the DagDetailDTO.
+func (c *Client) AddZoneSyncWithRequest(request *AddZoneRequest) (dag *model.DagDetailDTO, err error) {
+	if dag, err = c.AddZoneWithRequest(request); err != nil {
+		return nil, err
+	}
+	if dag == nil || dag.GenericDTO == nil {
+		return nil, nil
+	}
+	return c.WaitDagSucceed(dag.GenericID)
+}
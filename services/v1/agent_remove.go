@@ -24,6 +24,7 @@ import (
 
 type RemoveRequest struct {
 	*request.BaseRequest
+	agent model.AgentInfo
 }
 
 type removeResponse struct {
@@ -42,12 +43,12 @@ func (c *Client) createRemoveResponse() *removeResponse {
 func (c *Client) NewRemoveRequest(ip string, port int) *RemoveRequest {
 	req := &RemoveRequest{
 		BaseRequest: request.NewAsyncBaseRequest(),
+		agent: model.AgentInfo{
+			Ip:   ip,
+			Port: port,
+		},
 	}
-	req.SetBody(&model.AgentInfo{
-		Ip:   ip,
-		Port: port,
-	},
-	)
+	req.SetBody(&req.agent)
 	req.SetAuthentication()
 	req.InitApiInfo("/api/v1/agent/remove", c.GetHost(), c.GetPort(), "POST")
 	return req
@@ -66,6 +67,9 @@ func (c *Client) Remove(ip string, port int) (*model.DagDetailDTO, error) {
 // You can use WaitDagSucceed to wait for the task to complete.
 // You can check or operater the task through the DagDetailDTO.
 func (c *Client) RemoveWithRequest(req *RemoveRequest) (dag *model.DagDetailDTO, err error) {
+	if err = c.checkRemoveGuardrail(req); err != nil {
+		return nil, err
+	}
 	response := c.createRemoveResponse()
 	if err = c.Execute(req, response); err != nil {
 		return nil, err
@@ -75,11 +75,13 @@ func (c *Client) UpgradeAgent(version, release string) (*model.DagDetailDTO, err
 // the parameter is a UpgradeAgentRequest, which can be created by NewUpgradeAgentRequest.
 // You may need to call WaitDagSucceedWithRetry instead of WaitDagSucceed to query the task status.
 // You can check or operater the task through the DagDetailDTO.
+// The cached agent version is cleared, call ResetAgentVersion again after the task is completed if you wait for it by yourself.
 func (c *Client) UpgradeAgentWithRequest(req *UpgradeAgentRequest) (dag *model.DagDetailDTO, err error) {
 	response := c.createUpgradeAgentResponse()
 	if err = c.Execute(req, response); err != nil {
 		return nil, err
 	}
+	c.ResetAgentVersion()
 	return response.DagDetailDTO, nil
 }
 
@@ -92,5 +94,6 @@ func (c *Client) UpgradeAgentSyncWithRequest(req *UpgradeAgentRequest) (*model.D
 	if err != nil {
 		return nil, err
 	}
+	defer c.ResetAgentVersion()
 	return c.WaitDagSucceedWithRetry(dag.GenericID, 600)
 }
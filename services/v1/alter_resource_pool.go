@@ -0,0 +1,118 @@
+/*
+ * Copyright (c) 2024 OceanBase.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1
+
+import (
+	"fmt"
+
+	"github.com/oceanbase/obshell-sdk-go/model"
+	"github.com/oceanbase/obshell-sdk-go/sdk/request"
+	"github.com/oceanbase/obshell-sdk-go/sdk/response"
+)
+
+type AlterResourcePoolRequest struct {
+	*request.BaseRequest
+	param AlterResourcePoolParam
+}
+
+type AlterResourcePoolParam struct {
+	UnitNum        *int    `json:"unit_num"`         // optional
+	UnitConfigName *string `json:"unit_config_name"` // optional
+}
+
+type AlterResourcePoolResponse struct {
+	*response.TaskResponse
+}
+
+func (c *Client) createAlterResourcePoolResponse() *AlterResourcePoolResponse {
+	return &AlterResourcePoolResponse{
+		TaskResponse: response.NewTaskResponse(),
+	}
+}
+
+// NewAlterResourcePoolRequest return a AlterResourcePoolRequest, which can be used as the argument for the AlterResourcePoolWithRequest/AlterResourcePoolSyncWithRequest.
+// poolName: the name of the resource pool.
+// You can set the unit num and unit config by calling SetUnitNum and SetUnitConfigName.
+// If the resource pool is used by a tenant, the units of the tenant in all zones of the pool will be changed.
+// To change the units of a tenant in only some zones, use ModifyTenantReplicas instead.
+func (c *Client) NewAlterResourcePoolRequest(poolName string) *AlterResourcePoolRequest {
+	req := &AlterResourcePoolRequest{
+		BaseRequest: request.NewAsyncBaseRequest(),
+	}
+	req.SetBody(&req.param)
+	req.SetAuthentication()
+	req.InitApiInfo(fmt.Sprintf("/api/v1/resource-pool/%s", poolName), c.GetHost(), c.GetPort(), "PATCH")
+	return req
+}
+
+// SetUnitNum sets the unit number of each zone of the resource pool.
+func (r *AlterResourcePoolRequest) SetUnitNum(unitNum int) *AlterResourcePoolRequest {
+	r.param.UnitNum = &unitNum
+	r.SetBody(&r.param)
+	return r
+}
+
+// SetUnitConfigName sets the resource unit config used by the units of the resource pool.
+func (r *AlterResourcePoolRequest) SetUnitConfigName(unitConfigName string) *AlterResourcePoolRequest {
+	r.param.UnitConfigName = &unitConfigName
+	r.SetBody(&r.param)
+	return r
+}
+
+// AlterResourcePoolUnitNum returns a DagDetailDTO and an error, when the task is completed successfully, the error will be nil.
+// poolName: the name of the resource pool.
+// unitNum: the new unit number of each zone.
+func (c *Client) AlterResourcePoolUnitNum(poolName string, unitNum int) (*model.DagDetailDTO, error) {
+	request := c.NewAlterResourcePoolRequest(poolName).SetUnitNum(unitNum)
+	return c.AlterResourcePoolSyncWithRequest(request)
+}
+
+// AlterResourcePoolUnitConfig returns a DagDetailDTO and an error, when the task is completed successfully, the error will be nil.
+// poolName: the name of the resource pool.
+// unitConfigName: the name of the new resource unit config.
+func (c *Client) AlterResourcePoolUnitConfig(poolName string, unitConfigName string) (*model.DagDetailDTO, error) {
+	request := c.NewAlterResourcePoolRequest(poolName).SetUnitConfigName(unitConfigName)
+	return c.AlterResourcePoolSyncWithRequest(request)
+}
+
+// AlterResourcePoolWithRequest returns a DagDetailDTO and an error, when the task is requested successfully, the error will be nil.
+// the parameter is a AlterResourcePoolRequest, which can be created by NewAlterResourcePoolRequest.
+// You can use WaitDagSucceed to wait for the task to complete.
+// You can check or operater the task through the DagDetailDTO.
+// If nothing needs to be changed, the DagDetailDTO will be nil.
+func (c *Client) AlterResourcePoolWithRequest(request *AlterResourcePoolRequest) (dag *model.DagDetailDTO, err error) {
+	response := c.createAlterResourcePoolResponse()
+	if err = c.Execute(request, response); err != nil {
+		return nil, err
+	}
+	return response.DagDetailDTO, nil
+}
+
+// AlterResourcePoolSyncWithRequest returns a DagDetailDTO and an error, when the task is completed successfully, the error will be nil.
+// the DagDetailDTO is the final status of the task.
+// the parameter is a AlterResourcePoolRequest, which can be created by NewAlterResourcePoolRequest.
+// You can check or operater the task through the DagDetailDTO.
+// If nothing needs to be changed, the DagDetailDTO will be nil.
+func (c *Client) AlterResourcePoolSyncWithRequest(request *AlterResourcePoolRequest) (dag *model.DagDetailDTO, err error) {
+	if dag, err = c.AlterResourcePoolWithRequest(request); err != nil {
+		return nil, err
+	}
+	if dag == nil || dag.GenericDTO == nil {
+		return nil, nil
+	}
+	return c.WaitDagSucceed(dag.GenericID)
+}
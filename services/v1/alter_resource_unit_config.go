@@ -0,0 +1,141 @@
+/*
+ * Copyright (c) 2024 OceanBase.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1
+
+import (
+	"fmt"
+
+	"github.com/oceanbase/obshell-sdk-go/model"
+	"github.com/oceanbase/obshell-sdk-go/sdk/request"
+	"github.com/oceanbase/obshell-sdk-go/sdk/response"
+)
+
+type AlterResourceUnitConfigRequest struct {
+	*request.BaseRequest
+	param AlterResourceUnitConfigParam
+}
+
+type AlterResourceUnitConfigParam struct {
+	MemorySize  *string  `json:"memory_size"`   // MemorySize should be greater than or equal to '1G'.
+	MaxCpu      *float64 `json:"max_cpu"`       // MaxCpu should be greater than 0.
+	MinCpu      *float64 `json:"min_cpu"`       // MinCpu should be smaller than or equal MaxCpu.
+	MaxIops     *int     `json:"max_iops"`      // MaxIops should be greater than or equal to 1024.
+	MinIops     *int     `json:"min_iops"`      // MinIops should be smaller than or equal to MaxIops.
+	LogDiskSize *string  `json:"log_disk_size"` // LogDiskSize should be greater than or equal to '2G'.
+}
+
+type alterResourceUnitConfigResponse struct {
+	*response.OcsAgentResponse
+}
+
+func (c *Client) createAlterResourceUnitConfigResponse() *alterResourceUnitConfigResponse {
+	return &alterResourceUnitConfigResponse{
+		OcsAgentResponse: response.NewOcsAgentResponseWithoutReturn(),
+	}
+}
+
+// NewAlterResourceUnitConfigRequest return a AlterResourceUnitConfigRequest, which can be used as the argument for the AlterResourceUnitConfigWithRequest.
+// unitConfigName: the name of the resource unit config.
+// You can set the memorySize, maxCpu, minCpu, maxIops, minIops, logDiskSize by calling SetMemorySize, SetMaxCpu, SetMinCpu, SetMaxIops, SetMinIops, SetLogDiskSize.
+// Only the fields which have been set will be modified.
+func (c *Client) NewAlterResourceUnitConfigRequest(unitConfigName string) *AlterResourceUnitConfigRequest {
+	req := &AlterResourceUnitConfigRequest{
+		BaseRequest: request.NewBaseRequest(),
+	}
+	req.InitApiInfo(fmt.Sprintf("/api/v1/unit/config/%s", unitConfigName), c.GetHost(), c.GetPort(), "PATCH")
+	req.SetBody(&req.param)
+	req.SetAuthentication()
+	return req
+}
+
+func (r *AlterResourceUnitConfigRequest) SetMemorySize(memorySize string) *AlterResourceUnitConfigRequest {
+	r.param.MemorySize = &memorySize
+	r.SetBody(&r.param)
+	return r
+}
+
+func (r *AlterResourceUnitConfigRequest) SetMaxCpu(maxCpu float64) *AlterResourceUnitConfigRequest {
+	r.param.MaxCpu = &maxCpu
+	r.SetBody(&r.param)
+	return r
+}
+
+func (r *AlterResourceUnitConfigRequest) SetMinCpu(minCpu float64) *AlterResourceUnitConfigRequest {
+	r.param.MinCpu = &minCpu
+	r.SetBody(&r.param)
+	return r
+}
+
+func (r *AlterResourceUnitConfigRequest) SetMaxIops(maxIops int) *AlterResourceUnitConfigRequest {
+	r.param.MaxIops = &maxIops
+	r.SetBody(&r.param)
+	return r
+}
+
+func (r *AlterResourceUnitConfigRequest) SetMinIops(minIops int) *AlterResourceUnitConfigRequest {
+	r.param.MinIops = &minIops
+	r.SetBody(&r.param)
+	return r
+}
+
+func (r *AlterResourceUnitConfigRequest) SetLogDiskSize(logDiskSize string) *AlterResourceUnitConfigRequest {
+	r.param.LogDiskSize = &logDiskSize
+	r.SetBody(&r.param)
+	return r
+}
+
+// AlterResourceUnitConfig modifies a resource unit config in place.
+// unitConfigName: the name of the resource unit config.
+// param: the fields to be modified, the nil fields will not be modified.
+// All the units using this config will be changed, you can use GetTenantsByUnitConfig to find the affected tenants first.
+func (c *Client) AlterResourceUnitConfig(unitConfigName string, param AlterResourceUnitConfigParam) error {
+	request := c.NewAlterResourceUnitConfigRequest(unitConfigName)
+	request.param = param
+	request.SetBody(&request.param)
+	return c.AlterResourceUnitConfigWithRequest(request)
+}
+
+// AlterResourceUnitConfigWithRequest modifies a resource unit config with the AlterResourceUnitConfigRequest.
+func (c *Client) AlterResourceUnitConfigWithRequest(request *AlterResourceUnitConfigRequest) error {
+	response := c.createAlterResourceUnitConfigResponse()
+	return c.Execute(request, response)
+}
+
+// GetTenantsByUnitConfig returns the tenants whose resource pools use the resource unit config.
+// unitConfigName: the name of the resource unit config.
+// It can be used to check which tenants will be affected before altering or dropping the resource unit config.
+func (c *Client) GetTenantsByUnitConfig(unitConfigName string) ([]*model.TenantInfo, error) {
+	overviews, err := c.GetAllTenantOverview()
+	if err != nil {
+		return nil, err
+	}
+
+	tenants := make([]*model.TenantInfo, 0)
+	for _, overview := range overviews {
+		tenant, err := c.GetTenantInfo(overview.Name)
+		if err != nil {
+			return nil, err
+		}
+		for _, pool := range tenant.Pools {
+			if pool.Unit != nil && pool.Unit.Name == unitConfigName {
+				tenants = append(tenants, tenant)
+				break
+			}
+		}
+	}
+	return tenants, nil
+}
@@ -0,0 +1,92 @@
+/*
+ * Copyright (c) 2024 OceanBase.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1
+
+import (
+	"fmt"
+
+	"github.com/oceanbase/obshell-sdk-go/sdk/request"
+	"github.com/oceanbase/obshell-sdk-go/sdk/response"
+)
+
+type AlterZoneRequest struct {
+	*request.BaseRequest
+	param AlterZoneParam
+}
+
+type AlterZoneParam struct {
+	Region *string `json:"region,omitempty"`
+	Idc    *string `json:"idc,omitempty"`
+}
+
+type alterZoneResponse struct {
+	*response.OcsAgentResponse
+}
+
+func (c *Client) createAlterZoneResponse() *alterZoneResponse {
+	return &alterZoneResponse{
+		OcsAgentResponse: response.NewOcsAgentResponseWithoutReturn(),
+	}
+}
+
+// NewAlterZoneRequest returns an AlterZoneRequest, which can be used as the argument for the AlterZoneWithRequest.
+// zoneName: the name of zone to be altered.
+// You can use SetRegion and SetIdc to set the attributes to be altered, the attributes not set will not be changed.
+func (c *Client) NewAlterZoneRequest(zoneName string) *AlterZoneRequest {
+	req := &AlterZoneRequest{
+		BaseRequest: request.NewBaseRequest(),
+	}
+	req.SetBody(&req.param)
+	req.SetAuthentication()
+	req.InitApiInfo(fmt.Sprintf("/api/v1/zone/%s", zoneName), c.GetHost(), c.GetPort(), "PATCH")
+	return req
+}
+
+// SetRegion sets the region of the zone.
+func (r *AlterZoneRequest) SetRegion(region string) *AlterZoneRequest {
+	r.param.Region = &region
+	r.SetBody(&r.param)
+	return r
+}
+
+// SetIdc sets the idc of the zone.
+func (r *AlterZoneRequest) SetIdc(idc string) *AlterZoneRequest {
+	r.param.Idc = &idc
+	r.SetBody(&r.param)
+	return r
+}
+
+// AlterZone alters the region and idc of the zone.
+// zoneName: the name of zone to be altered.
+// region: the new region of the zone, empty means not to change.
+// idc: the new idc of the zone, empty means not to change.
+func (c *Client) AlterZone(zoneName string, region string, idc string) error {
+	request := c.NewAlterZoneRequest(zoneName)
+	if region != "" {
+		request.SetRegion(region)
+	}
+	if idc != "" {
+		request.SetIdc(idc)
+	}
+	return c.AlterZoneWithRequest(request)
+}
+
+// AlterZoneWithRequest alters the attributes of the zone with an AlterZoneRequest.
+func (c *Client) AlterZoneWithRequest(request *AlterZoneRequest) error {
+	response := c.createAlterZoneResponse()
+	return c.Execute(request, response)
+}
@@ -0,0 +1,218 @@
+/*
+ * Copyright (c) 2024 OceanBase.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/oceanbase/obshell-sdk-go/internal/util"
+	"github.com/oceanbase/obshell-sdk-go/sdk/request"
+	"github.com/oceanbase/obshell-sdk-go/sdk/response"
+)
+
+// features of obshell
+const (
+	FEATURE_BACKUP                = "backup"
+	FEATURE_RESTORE               = "restore"
+	FEATURE_TENANT                = "tenant"
+	FEATURE_RESOURCE_POOL         = "resource pool"
+	FEATURE_RECYCLEBIN            = "recyclebin"
+	FEATURE_TENANT_USER           = "tenant user"
+	FEATURE_TENANT_DATABASE       = "tenant database"
+	FEATURE_TENANT_RESOURCE_STATS = "tenant resource stats"
+	FEATURE_ARCHIVE_LOG_STATUS    = "archive log status"
+	FEATURE_ALTER_RESOURCE_POOL   = "alter resource pool"
+	FEATURE_ALTER_UNIT_CONFIG     = "alter unit config"
+	FEATURE_STANDBY_TENANT        = "standby tenant"
+	FEATURE_ZONE                  = "zone"
+)
+
+var ErrUnsupportedByAgent = errors.New("unsupported by agent")
+
+// UnsupportedByAgentError is returned before sending the request when the agent is older than
+// the minimum version of the feature. It can be checked by errors.Is(err, v1.ErrUnsupportedByAgent).
+type UnsupportedByAgentError struct {
+	Feature      string
+	MinVersion   string
+	AgentVersion string
+}
+
+func (e *UnsupportedByAgentError) Error() string {
+	return fmt.Sprintf("%s is unsupported by agent %s, requires obshell %s or later", e.Feature, e.AgentVersion, e.MinVersion)
+}
+
+func (e *UnsupportedByAgentError) Is(target error) bool {
+	return target == ErrUnsupportedByAgent
+}
+
+// capabilities are the minimum obshell versions of the features.
+// The APIs served by every release, such as the cluster and the task APIs, are not gated.
+var capabilities = map[string]string{
+	// obshell 4.2.3.0 introduces the tenant management, backup and restore.
+	FEATURE_BACKUP:        "4.2.3.0",
+	FEATURE_RESTORE:       "4.2.3.0",
+	FEATURE_TENANT:        "4.2.3.0",
+	FEATURE_RESOURCE_POOL: "4.2.3.0",
+	FEATURE_RECYCLEBIN:    "4.2.3.0",
+	// obshell 4.2.5.0 introduces the tenant users, databases and the monitoring APIs used by the dashboard.
+	FEATURE_TENANT_USER:           "4.2.5.0",
+	FEATURE_TENANT_DATABASE:       "4.2.5.0",
+	FEATURE_TENANT_RESOURCE_STATS: "4.2.5.0",
+	FEATURE_ARCHIVE_LOG_STATUS:    "4.2.5.0",
+	// obshell 4.3.5.0 introduces the standby tenants, the zone management and altering the resources.
+	FEATURE_ALTER_RESOURCE_POOL: "4.3.5.0",
+	FEATURE_ALTER_UNIT_CONFIG:   "4.3.5.0",
+	FEATURE_STANDBY_TENANT:      "4.3.5.0",
+	FEATURE_ZONE:                "4.3.5.0",
+}
+
+// capabilitiesLock guards capabilities, which may be registered while the requests are executed.
+var capabilitiesLock sync.RWMutex
+
+// featureRoutes maps the request to the feature, the first matched route wins.
+// An empty method matches every method.
+// '*' matches a segment of the uri, and a route matches the uri which starts with it.
+var featureRoutes = []struct {
+	method  string
+	pattern string
+	feature string
+}{
+	{"GET", "/api/v1/tenant/*/backup/log", FEATURE_ARCHIVE_LOG_STATUS},
+	{"", "/api/v1/obcluster/backup", FEATURE_BACKUP},
+	{"", "/api/v1/tenant/*/backup", FEATURE_BACKUP},
+	{"", "/api/v1/tenant/restore", FEATURE_RESTORE},
+	{"", "/api/v1/tenant/*/restore", FEATURE_RESTORE},
+	{"", "/api/v1/tenant/standby", FEATURE_STANDBY_TENANT},
+	{"", "/api/v1/tenant/*/activate", FEATURE_STANDBY_TENANT},
+	{"", "/api/v1/tenant/*/failover", FEATURE_STANDBY_TENANT},
+	{"", "/api/v1/tenant/*/switchover", FEATURE_STANDBY_TENANT},
+	{"", "/api/v1/tenant/*/role", FEATURE_STANDBY_TENANT},
+	{"", "/api/v1/tenant/*/user", FEATURE_TENANT_USER},
+	{"", "/api/v1/tenant/*/users", FEATURE_TENANT_USER},
+	{"", "/api/v1/tenant/*/database", FEATURE_TENANT_DATABASE},
+	{"", "/api/v1/tenant/*/databases", FEATURE_TENANT_DATABASE},
+	{"", "/api/v1/tenant/*/resource-stats", FEATURE_TENANT_RESOURCE_STATS},
+	{"", "/api/v1/tenants/resource-stats", FEATURE_TENANT_RESOURCE_STATS},
+	{"", "/api/v1/tenant", FEATURE_TENANT},
+	{"", "/api/v1/tenants", FEATURE_TENANT},
+	{"POST", "/api/v1/resource-pool", FEATURE_ALTER_RESOURCE_POOL},
+	{"PATCH", "/api/v1/resource-pool/*", FEATURE_ALTER_RESOURCE_POOL},
+	{"", "/api/v1/resource-pool/*/split", FEATURE_ALTER_RESOURCE_POOL},
+	{"", "/api/v1/resource-pools/merge", FEATURE_ALTER_RESOURCE_POOL},
+	{"", "/api/v1/resource-pool", FEATURE_RESOURCE_POOL},
+	{"", "/api/v1/resource-pools", FEATURE_RESOURCE_POOL},
+	{"PATCH", "/api/v1/unit/config/*", FEATURE_ALTER_UNIT_CONFIG},
+	{"", "/api/v1/unit/config", FEATURE_RESOURCE_POOL},
+	{"", "/api/v1/units/config", FEATURE_RESOURCE_POOL},
+	{"", "/api/v1/recyclebin", FEATURE_RECYCLEBIN},
+	// Deleting a zone is served before the other zone APIs, so it is not gated.
+	{"DELETE", "/api/v1/zone/*", ""},
+	{"", "/api/v1/zone", FEATURE_ZONE},
+	{"", "/api/v1/zones", FEATURE_ZONE},
+}
+
+// RegisterCapability sets the minimum obshell version of the feature.
+func RegisterCapability(feature string, minVersion string) {
+	capabilitiesLock.Lock()
+	defer capabilitiesLock.Unlock()
+	capabilities[feature] = minVersion
+}
+
+// GetMinAgentVersion returns the minimum obshell version of the feature,
+// empty string if the feature has no requirement.
+func GetMinAgentVersion(feature string) string {
+	capabilitiesLock.RLock()
+	defer capabilitiesLock.RUnlock()
+	return capabilities[feature]
+}
+
+// Supports returns whether the agent supports the feature.
+func (c *Client) Supports(feature string) (bool, error) {
+	minVersion := GetMinAgentVersion(feature)
+	if minVersion == "" {
+		return true, nil
+	}
+	version, err := c.GetAgentVersion()
+	if err != nil {
+		return false, err
+	}
+	return util.CmpVersionString(strings.Split(version, "-")[0], minVersion) >= 0, nil
+}
+
+// checkSupported returns an UnsupportedByAgentError if the agent does not support the feature,
+// or the error of getting the version of the agent.
+func (c *Client) checkSupported(feature string) error {
+	supported, err := c.Supports(feature)
+	if err != nil {
+		return err
+	} else if supported {
+		return nil
+	}
+	version, _ := c.GetAgentVersion()
+	return &UnsupportedByAgentError{
+		Feature:      feature,
+		MinVersion:   GetMinAgentVersion(feature),
+		AgentVersion: version,
+	}
+}
+
+// Execute checks whether the agent supports the feature of the request before sending it.
+// The request is not sent if the version of the agent can not be got.
+func (c *Client) Execute(req request.Request, resp response.Response) error {
+	if uri, err := req.GetUri(); err == nil {
+		if feature := getFeature(req.GetMethod(), uri); feature != "" {
+			if err = c.checkSupported(feature); err != nil {
+				return err
+			}
+		}
+	}
+	return c.Client.Execute(req, resp)
+}
+
+func getFeature(method, uri string) string {
+	u, err := url.Parse(uri)
+	if err != nil {
+		return ""
+	}
+	segments := strings.Split(strings.Trim(u.Path, "/"), "/")
+	for _, route := range featureRoutes {
+		if route.method != "" && route.method != method {
+			continue
+		}
+		if matchUri(strings.Split(strings.Trim(route.pattern, "/"), "/"), segments) {
+			return route.feature
+		}
+	}
+	return ""
+}
+
+func matchUri(pattern, segments []string) bool {
+	if len(segments) < len(pattern) {
+		return false
+	}
+	for i, p := range pattern {
+		if p != "*" && p != segments[i] {
+			return false
+		}
+	}
+	return true
+}
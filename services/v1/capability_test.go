@@ -0,0 +1,231 @@
+/*
+ * Copyright (c) 2024 OceanBase.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// expectedFeatures is the feature of every api built by the package, '%s' and the variables are rendered as 'x',
+// including the method passed as a variable.
+// A new api must be added here, so that it is never gated by mistake.
+var expectedFeatures = map[string]string{
+	"DELETE /api/v1/recyclebin/tenant/x":           FEATURE_RECYCLEBIN,
+	"DELETE /api/v1/resource-pool/x":               FEATURE_RESOURCE_POOL,
+	"DELETE /api/v1/tenant/x":                      FEATURE_TENANT,
+	"DELETE /api/v1/tenant/x/database/x":           FEATURE_TENANT_DATABASE,
+	"DELETE /api/v1/tenant/x/lock":                 FEATURE_TENANT,
+	"DELETE /api/v1/tenant/x/replicas":             FEATURE_TENANT,
+	"DELETE /api/v1/tenant/x/restore":              FEATURE_RESTORE,
+	"DELETE /api/v1/tenant/x/user/x":               FEATURE_TENANT_USER,
+	"DELETE /api/v1/tenant/x/user/x/db-privileges": FEATURE_TENANT_USER,
+	"DELETE /api/v1/tenant/x/user/x/lock":          FEATURE_TENANT_USER,
+	"DELETE /api/v1/unit/config/x":                 FEATURE_RESOURCE_POOL,
+	"DELETE /api/v1/zone/x":                        "",
+	"GET /api/v1/git-info":                         "",
+	"GET /api/v1/info":                             "",
+	"GET /api/v1/ob/info":                          "",
+	"GET /api/v1/obcluster/backup/overview":        FEATURE_BACKUP,
+	"GET /api/v1/recyclebin/tenants":               FEATURE_RECYCLEBIN,
+	"GET /api/v1/resource-pools":                   FEATURE_RESOURCE_POOL,
+	"GET /api/v1/status":                           "",
+	"GET /api/v1/task/dag/agent/unfinish":          "",
+	"GET /api/v1/task/dag/maintain/agent":          "",
+	"GET /api/v1/task/dag/maintain/agents":         "",
+	"GET /api/v1/task/dag/maintain/ob":             "",
+	"GET /api/v1/task/dag/ob/unfinish":             "",
+	"GET /api/v1/task/dag/unfinish":                "",
+	"GET /api/v1/task/dag/x":                       "",
+	"GET /api/v1/task/node/x":                      "",
+	"GET /api/v1/task/sub_task/x":                  "",
+	"GET /api/v1/tenant/x":                         FEATURE_TENANT,
+	"GET /api/v1/tenant/x/backup/log":              FEATURE_ARCHIVE_LOG_STATUS,
+	"GET /api/v1/tenant/x/backup/overview":         FEATURE_BACKUP,
+	"GET /api/v1/tenant/x/databases":               FEATURE_TENANT_DATABASE,
+	"GET /api/v1/tenant/x/parameter/x":             FEATURE_TENANT,
+	"GET /api/v1/tenant/x/parameters":              FEATURE_TENANT,
+	"GET /api/v1/tenant/x/resource-stats":          FEATURE_TENANT_RESOURCE_STATS,
+	"GET /api/v1/tenant/x/restore/overview":        FEATURE_RESTORE,
+	"GET /api/v1/tenant/x/role":                    FEATURE_STANDBY_TENANT,
+	"GET /api/v1/tenant/x/users":                   FEATURE_TENANT_USER,
+	"GET /api/v1/tenant/x/variable/x":              FEATURE_TENANT,
+	"GET /api/v1/tenant/x/variables":               FEATURE_TENANT,
+	"GET /api/v1/tenants/overview":                 FEATURE_TENANT,
+	"GET /api/v1/tenants/resource-stats":           FEATURE_TENANT_RESOURCE_STATS,
+	"GET /api/v1/unit/config/x":                    FEATURE_RESOURCE_POOL,
+	"GET /api/v1/units/config":                     FEATURE_RESOURCE_POOL,
+	"GET /api/v1/zone/x":                           FEATURE_ZONE,
+	"GET /api/v1/zones":                            FEATURE_ZONE,
+	"PATCH /api/v1/obcluster/backup":               FEATURE_BACKUP,
+	"PATCH /api/v1/obcluster/backup/log":           FEATURE_BACKUP,
+	"PATCH /api/v1/resource-pool/x":                FEATURE_ALTER_RESOURCE_POOL,
+	"PATCH /api/v1/tenant/x/backup":                FEATURE_BACKUP,
+	"PATCH /api/v1/tenant/x/backup/log":            FEATURE_BACKUP,
+	"PATCH /api/v1/tenant/x/database/x":            FEATURE_TENANT_DATABASE,
+	"PATCH /api/v1/tenant/x/replicas":              FEATURE_TENANT,
+	"PATCH /api/v1/unit/config/x":                  FEATURE_ALTER_UNIT_CONFIG,
+	"PATCH /api/v1/zone/x":                         FEATURE_ZONE,
+	"POST /api/v1/agent/join":                      "",
+	"POST /api/v1/agent/remove":                    "",
+	"POST /api/v1/agent/upgrade":                   "",
+	"POST /api/v1/agent/upgrade/check":             "",
+	"POST /api/v1/ob/init":                         "",
+	"POST /api/v1/ob/scale_in":                     "",
+	"POST /api/v1/ob/scale_out":                    "",
+	"POST /api/v1/ob/start":                        "",
+	"POST /api/v1/ob/stop":                         "",
+	"POST /api/v1/ob/upgrade":                      "",
+	"POST /api/v1/ob/upgrade/check":                "",
+	"POST /api/v1/obcluster/backup":                FEATURE_BACKUP,
+	"POST /api/v1/obcluster/config":                "",
+	"POST /api/v1/observer/config":                 "",
+	"POST /api/v1/recyclebin/tenant/x":             FEATURE_RECYCLEBIN,
+	"POST /api/v1/resource-pool":                   FEATURE_ALTER_RESOURCE_POOL,
+	"POST /api/v1/resource-pool/x/split":           FEATURE_ALTER_RESOURCE_POOL,
+	"POST /api/v1/resource-pools/merge":            FEATURE_ALTER_RESOURCE_POOL,
+	"POST /api/v1/task/dag/x":                      "",
+	"POST /api/v1/tenant":                          FEATURE_TENANT,
+	"POST /api/v1/tenant/restore":                  FEATURE_RESTORE,
+	"POST /api/v1/tenant/standby":                  FEATURE_STANDBY_TENANT,
+	"POST /api/v1/tenant/x/activate":               FEATURE_STANDBY_TENANT,
+	"POST /api/v1/tenant/x/backup":                 FEATURE_BACKUP,
+	"POST /api/v1/tenant/x/database":               FEATURE_TENANT_DATABASE,
+	"POST /api/v1/tenant/x/failover":               FEATURE_STANDBY_TENANT,
+	"POST /api/v1/tenant/x/lock":                   FEATURE_TENANT,
+	"POST /api/v1/tenant/x/replicas":               FEATURE_TENANT,
+	"POST /api/v1/tenant/x/switchover":             FEATURE_STANDBY_TENANT,
+	"POST /api/v1/tenant/x/user":                   FEATURE_TENANT_USER,
+	"POST /api/v1/tenant/x/user/x/db-privileges":   FEATURE_TENANT_USER,
+	"POST /api/v1/tenant/x/user/x/lock":            FEATURE_TENANT_USER,
+	"POST /api/v1/unit/config":                     FEATURE_RESOURCE_POOL,
+	"POST /api/v1/upgrade/package":                 "",
+	"POST /api/v1/zone":                            FEATURE_ZONE,
+	"PUT /api/v1/tenant/x/name":                    FEATURE_TENANT,
+	"PUT /api/v1/tenant/x/parameters":              FEATURE_TENANT,
+	"PUT /api/v1/tenant/x/password":                FEATURE_TENANT,
+	"PUT /api/v1/tenant/x/primary-zone":            FEATURE_TENANT,
+	"PUT /api/v1/tenant/x/user/x/password":         FEATURE_TENANT_USER,
+	"PUT /api/v1/tenant/x/variables":               FEATURE_TENANT,
+	"PUT /api/v1/tenant/x/whitelist":               FEATURE_TENANT,
+	"x /api/v1/obcluster/backup/config":            FEATURE_BACKUP,
+	"x /api/v1/tenant/x/backup/config":             FEATURE_BACKUP,
+}
+
+func TestGetFeature(t *testing.T) {
+	uris := collectUris(t)
+	if len(uris) == 0 {
+		t.Fatal("no uri is found")
+	}
+	for _, api := range uris {
+		expected, ok := expectedFeatures[api]
+		if !ok {
+			t.Errorf("%s is not in expectedFeatures", api)
+			continue
+		}
+		method, uri, _ := strings.Cut(api, " ")
+		if feature := getFeature(method, uri); feature != expected {
+			t.Errorf("getFeature(%s, %s) = %q, expected %q", method, uri, feature, expected)
+		}
+	}
+}
+
+// collectUris returns the methods and the uris passed to InitApiInfo in the package, such as 'GET /api/v1/info'.
+func collectUris(t *testing.T) []string {
+	files, err := filepath.Glob("*.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	fset := token.NewFileSet()
+	set := make(map[string]bool)
+	for _, file := range files {
+		if strings.HasSuffix(file, "_test.go") {
+			continue
+		}
+		f, err := parser.ParseFile(fset, file, nil, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, decl := range f.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Body == nil {
+				continue
+			}
+			// The local variables assigned in the function, such as 'uri := fmt.Sprintf(...)'.
+			vars := make(map[string]ast.Expr)
+			ast.Inspect(fn.Body, func(n ast.Node) bool {
+				switch node := n.(type) {
+				case *ast.AssignStmt:
+					if len(node.Lhs) == 1 && len(node.Rhs) == 1 {
+						if ident, ok := node.Lhs[0].(*ast.Ident); ok {
+							vars[ident.Name] = node.Rhs[0]
+						}
+					}
+				case *ast.CallExpr:
+					if sel, ok := node.Fun.(*ast.SelectorExpr); ok && sel.Sel.Name == "InitApiInfo" && len(node.Args) == 4 {
+						uri, ok1 := renderUri(node.Args[0], vars)
+						method, ok2 := renderUri(node.Args[3], vars)
+						if ok1 && ok2 {
+							set[method+" "+uri] = true
+						} else {
+							t.Errorf("%s: can not render the uri of InitApiInfo", fset.Position(node.Pos()))
+						}
+					}
+				}
+				return true
+			})
+		}
+	}
+	uris := make([]string, 0, len(set))
+	for uri := range set {
+		uris = append(uris, uri)
+	}
+	sort.Strings(uris)
+	return uris
+}
+
+// renderUri renders the string literals, the concatenations and fmt.Sprintf, the other variables are rendered as 'x'.
+func renderUri(expr ast.Expr, vars map[string]ast.Expr) (string, bool) {
+	switch e := expr.(type) {
+	case *ast.BasicLit:
+		s, err := strconv.Unquote(e.Value)
+		return s, err == nil
+	case *ast.Ident:
+		if value, ok := vars[e.Name]; ok {
+			delete(vars, e.Name) // Avoid the endless recursion of 'uri = uri + ...'.
+			defer func() { vars[e.Name] = value }()
+			return renderUri(value, vars)
+		}
+		return "x", true
+	case *ast.BinaryExpr:
+		left, ok1 := renderUri(e.X, vars)
+		right, ok2 := renderUri(e.Y, vars)
+		return left + right, ok1 && ok2
+	case *ast.CallExpr:
+		if sel, ok := e.Fun.(*ast.SelectorExpr); ok && sel.Sel.Name == "Sprintf" && len(e.Args) > 0 {
+			format, ok := renderUri(e.Args[0], vars)
+			return strings.ReplaceAll(format, "%s", "x"), ok
+		}
+	}
+	return "", false
+}
@@ -24,6 +24,9 @@ import (
 
 type Client struct {
 	*sdk.Client
+
+	guardrail    *GuardrailPolicy
+	confirmToken string
 }
 
 // NewClient creates a new client with the given host and port.
@@ -31,20 +34,48 @@ type Client struct {
 // The client will use the given options to configure the client.
 // You can use the sdk.WithPasswordAuth option to set the password for the client.
 //
+// You can use the v1.WithGuardrail option to protect the destructive operations.
+//
 // AS: sdk.NewClient("127.0.0.1", 8080, sdk.WithPasswordAuth("password"))
 func NewClient(host string, port int, options ...option.Optioner) (*Client, error) {
 	c, err := sdk.NewClient(host, port, options...)
-	return &Client{c}, err
+	client := &Client{Client: c}
+	client.setGuardrailByOptions(options...)
+	return client, err
+}
+
+// NewClusterClient creates a cluster-aware client seeded with one or more agents('ip:port'),
+// the requests are routed to the MASTER or CLUSTER AGENT and fail over to another agent on network errors.
+// See sdk.NewClusterClient for details.
+func NewClusterClient(seeds []string, options ...option.Optioner) (*Client, error) {
+	c, err := sdk.NewClusterClient(seeds, options...)
+	if err != nil {
+		return nil, err
+	}
+	client := &Client{Client: c}
+	client.setGuardrailByOptions(options...)
+	return client, nil
 }
 
 func NewClientWithServer(host string, port int) (*Client, error) {
 	c, err := sdk.NewClientWithServer(host, port)
-	return &Client{c}, err
+	return &Client{Client: c}, err
 }
 
 func NewClientWithPassword(host string, port int, password string) (*Client, error) {
 	c, err := sdk.NewClientWithPassword(host, port, password)
-	return &Client{c}, err
+	return &Client{Client: c}, err
+}
+
+// newAgentClient creates a client of another agent with the options of the client, the requests of
+// which fail after sdk.DEFAULT_AGENT_PROBE_TIMEOUT, so that an unreachable agent does not block the caller.
+func (c *Client) newAgentClient(host string, port int) (*Client, error) {
+	client, err := c.Client.NewAgentClient(host, port)
+	if err != nil {
+		return nil, err
+	}
+	client.SetTimeout(sdk.DEFAULT_AGENT_PROBE_TIMEOUT)
+	return &Client{Client: client}, nil
 }
 
 func (c *Client) setPasswordCandidateAuth(password string) {
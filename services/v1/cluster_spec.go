@@ -0,0 +1,307 @@
+/*
+ * Copyright (c) 2024 OceanBase.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+var ErrInvalidClusterSpec = errors.New("invalid cluster spec")
+
+// ClusterSpec describes the topology and the configurations of a cluster to be created.
+type ClusterSpec struct {
+	Name         string            `json:"name"`
+	ID           int               `json:"id"`
+	Password     string            `json:"password"`
+	ImportScript bool              `json:"import_script"`
+	Configs      map[string]string `json:"configs"` // The observer configs of SCOPE_GLOBAL.
+	Zones        []ZoneSpec        `json:"zones"`
+}
+
+// ZoneSpec describes a zone of the cluster.
+type ZoneSpec struct {
+	Name    string            `json:"name"`
+	Configs map[string]string `json:"configs"` // The observer configs of SCOPE_ZONE.
+	Servers []ServerSpec      `json:"servers"`
+}
+
+// ServerSpec describes a server of the zone.
+type ServerSpec struct {
+	Host    string            `json:"host"`
+	Port    int               `json:"port"`
+	Configs map[string]string `json:"configs"` // The observer configs of SCOPE_SERVER.
+}
+
+func (s ServerSpec) String() string {
+	return fmt.Sprintf("%s:%d", s.Host, s.Port)
+}
+
+// Validate checks the spec, master is the server('ip:port') which the client connects to.
+// All the problems will be returned in one error wrapped with ErrInvalidClusterSpec.
+func (spec *ClusterSpec) Validate(master string) error {
+	var problems []string
+	if spec.Name == "" {
+		problems = append(problems, "cluster name is empty")
+	}
+	if spec.ID <= 0 {
+		problems = append(problems, fmt.Sprintf("cluster id %d is not positive", spec.ID))
+	}
+	if len(spec.Zones) == 0 {
+		problems = append(problems, "there is no zone")
+	}
+
+	zones := make(map[string]bool)
+	servers := make(map[string]string)
+	for i, zone := range spec.Zones {
+		if zone.Name == "" {
+			problems = append(problems, fmt.Sprintf("the name of zone #%d is empty", i))
+		} else if zones[zone.Name] {
+			problems = append(problems, fmt.Sprintf("zone '%s' is duplicated", zone.Name))
+		}
+		zones[zone.Name] = true
+		if len(zone.Servers) == 0 {
+			problems = append(problems, fmt.Sprintf("zone '%s' has no server", zone.Name))
+		}
+		for _, server := range zone.Servers {
+			if server.Host == "" || server.Port <= 0 {
+				problems = append(problems, fmt.Sprintf("server '%s' in zone '%s' is invalid", server, zone.Name))
+				continue
+			}
+			if prev, ok := servers[server.String()]; ok {
+				problems = append(problems, fmt.Sprintf("server '%s' is duplicated in zone '%s' and '%s'", server, prev, zone.Name))
+				continue
+			}
+			servers[server.String()] = zone.Name
+		}
+	}
+	if _, ok := servers[master]; !ok {
+		problems = append(problems, fmt.Sprintf("the master server '%s' is not in the server list", master))
+	}
+
+	if len(problems) != 0 {
+		return errors.Wrap(ErrInvalidClusterSpec, strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+// NewCreateClusterRequestFromSpec validates the spec and converts it to a CreateClusterRequest.
+// Use ClusterSpecExecutor if you need the progress or want to resume from a failed step.
+func (c *Client) NewCreateClusterRequestFromSpec(spec *ClusterSpec) (*CreateClusterRequest, error) {
+	if err := spec.Validate(c.GetServer()); err != nil {
+		return nil, err
+	}
+	req := c.NewCreateClusterRequest()
+	for _, zone := range spec.Zones {
+		for _, server := range zone.Servers {
+			req.AddServer(server.Host, server.Port, zone.Name)
+		}
+	}
+	spec.forEachConfig(func(configs map[string]string, level string, target string) {
+		if target == "" {
+			req.ConfigObserver(configs, level)
+		} else {
+			req.ConfigObserver(configs, level, target)
+		}
+	})
+	req.ConfigCluster(spec.Name, spec.ID, spec.Password)
+	req.SetImportScript(spec.ImportScript)
+	return req, nil
+}
+
+// forEachConfig visits the observer configs in the order of global, zone and server.
+func (spec *ClusterSpec) forEachConfig(fn func(configs map[string]string, level string, target string)) {
+	if len(spec.Configs) != 0 {
+		fn(spec.Configs, SCOPE_GLOBAL, "")
+	}
+	for _, zone := range spec.Zones {
+		if len(zone.Configs) != 0 {
+			fn(zone.Configs, SCOPE_ZONE, zone.Name)
+		}
+	}
+	for _, zone := range spec.Zones {
+		for _, server := range zone.Servers {
+			if len(server.Configs) != 0 {
+				fn(server.Configs, SCOPE_SERVER, server.String())
+			}
+		}
+	}
+}
+
+// ClusterSpecProgress is reported by ClusterSpecExecutor when a step starts or ends.
+type ClusterSpecProgress struct {
+	Step  int    // The index of the step, starts from 0.
+	Total int    // The total number of the steps.
+	Name  string // The description of the step.
+	Done  bool   // False when the step starts, true when the step ends.
+	Err   error  // The error of the step if it is failed.
+}
+
+type clusterSpecStep struct {
+	name string
+	run  func() error
+}
+
+// ClusterSpecExecutor creates a cluster by a ClusterSpec step by step.
+// The steps are: join the master, join the followers in the order of the spec,
+// config the observers(global, zone and server), config the obcluster and init the cluster.
+// When a step failed, Run can be called again to resume from the failed step.
+type ClusterSpecExecutor struct {
+	client    *Client
+	spec      *ClusterSpec
+	steps     []clusterSpecStep
+	completed int
+	progress  func(ClusterSpecProgress)
+}
+
+// NewClusterSpecExecutor validates the spec and returns a ClusterSpecExecutor.
+// The client must connect to the master server of the spec.
+func (c *Client) NewClusterSpecExecutor(spec *ClusterSpec) (*ClusterSpecExecutor, error) {
+	if err := spec.Validate(c.GetServer()); err != nil {
+		return nil, err
+	}
+	e := &ClusterSpecExecutor{
+		client: c,
+		spec:   spec,
+	}
+	e.buildSteps()
+	return e, nil
+}
+
+func (e *ClusterSpecExecutor) buildSteps() {
+	c := e.client
+	master := c.GetServer()
+	for _, zone := range e.spec.Zones {
+		for _, server := range zone.Servers {
+			if server.String() == master {
+				e.addJoinStep(server.String(), zone.Name)
+			}
+		}
+	}
+	for _, zone := range e.spec.Zones {
+		for _, server := range zone.Servers {
+			if server.String() != master {
+				e.addJoinStep(server.String(), zone.Name)
+			}
+		}
+	}
+
+	e.spec.forEachConfig(func(configs map[string]string, level string, target string) {
+		var targets []string
+		name := fmt.Sprintf("config observer of %s", strings.ToLower(level))
+		if target != "" {
+			targets = append(targets, target)
+			name = fmt.Sprintf("%s '%s'", name, target)
+		}
+		e.steps = append(e.steps, clusterSpecStep{
+			name: name,
+			run: func() error {
+				_, err := c.ConfigObserverSyncWithRequest(c.NewConfigObserverRequest(configs, level, targets...))
+				return err
+			},
+		})
+	})
+
+	e.steps = append(e.steps, clusterSpecStep{
+		name: fmt.Sprintf("config obcluster '%s'", e.spec.Name),
+		run: func() error {
+			req := c.NewConfigObclusterRequest(e.spec.Name, e.spec.ID)
+			if e.spec.Password != "" {
+				req.SetRootPwd(e.spec.Password)
+			}
+			_, err := c.ConfigObclusterSyncWithRequest(req)
+			return err
+		},
+	})
+	e.steps = append(e.steps, clusterSpecStep{
+		name: "init cluster",
+		run: func() error {
+			_, err := c.InitSyncWithRequest(c.NewInitRequest().SetImportScript(e.spec.ImportScript))
+			return err
+		},
+	})
+}
+
+func (e *ClusterSpecExecutor) addJoinStep(server, zone string) {
+	e.steps = append(e.steps, clusterSpecStep{
+		name: fmt.Sprintf("join '%s' to zone '%s'", server, zone),
+		run: func() error {
+			return e.client.join(server, zone)
+		},
+	})
+}
+
+// SetProgressCallback sets the callback which will be called when a step starts or ends.
+func (e *ClusterSpecExecutor) SetProgressCallback(callback func(ClusterSpecProgress)) *ClusterSpecExecutor {
+	e.progress = callback
+	return e
+}
+
+// SetCompletedSteps sets the number of the completed steps,
+// it can be used to resume the execution with a persisted progress.
+func (e *ClusterSpecExecutor) SetCompletedSteps(completed int) *ClusterSpecExecutor {
+	if completed < 0 {
+		completed = 0
+	} else if completed > len(e.steps) {
+		completed = len(e.steps)
+	}
+	e.completed = completed
+	return e
+}
+
+// CompletedSteps returns the number of the completed steps.
+func (e *ClusterSpecExecutor) CompletedSteps() int {
+	return e.completed
+}
+
+// Steps returns the description of all the steps in execution order.
+func (e *ClusterSpecExecutor) Steps() []string {
+	names := make([]string, 0, len(e.steps))
+	for _, step := range e.steps {
+		names = append(names, step.name)
+	}
+	return names
+}
+
+// IsFinished returns true if all the steps are completed.
+func (e *ClusterSpecExecutor) IsFinished() bool {
+	return e.completed == len(e.steps)
+}
+
+// Run executes the steps from the last completed one.
+// It returns an error once a step is failed, and the failed step will be executed again in the next Run.
+func (e *ClusterSpecExecutor) Run() error {
+	for e.completed < len(e.steps) {
+		step := e.steps[e.completed]
+		e.report(ClusterSpecProgress{Step: e.completed, Total: len(e.steps), Name: step.name})
+		if err := step.run(); err != nil {
+			e.report(ClusterSpecProgress{Step: e.completed, Total: len(e.steps), Name: step.name, Done: true, Err: err})
+			return errors.Wrapf(err, "step '%s' failed", step.name)
+		}
+		e.report(ClusterSpecProgress{Step: e.completed, Total: len(e.steps), Name: step.name, Done: true})
+		e.completed++
+	}
+	return nil
+}
+
+func (e *ClusterSpecExecutor) report(progress ClusterSpecProgress) {
+	if e.progress != nil {
+		e.progress(progress)
+	}
+}
@@ -19,6 +19,7 @@ package v1
 import (
 	"errors"
 	"fmt"
+	"sort"
 
 	"github.com/oceanbase/obshell-sdk-go/internal/util"
 	"github.com/oceanbase/obshell-sdk-go/model"
@@ -142,9 +143,14 @@ func (c *Client) CreateClusterWithRequest(req *CreateClusterRequest) (err error)
 	}
 	delete(req.server, c.GetServer())
 
-	// join follower
-	for server, zone := range req.server {
-		if err := c.join(server, zone); err != nil {
+	// join follower in order of the server
+	followers := make([]string, 0, len(req.server))
+	for server := range req.server {
+		followers = append(followers, server)
+	}
+	sort.Strings(followers)
+	for _, server := range followers {
+		if err := c.join(server, req.server[server]); err != nil {
 			return err
 		}
 	}
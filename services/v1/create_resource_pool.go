@@ -0,0 +1,105 @@
+/*
+ * Copyright (c) 2024 OceanBase.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1
+
+import (
+	"github.com/oceanbase/obshell-sdk-go/model"
+	"github.com/oceanbase/obshell-sdk-go/sdk/request"
+	"github.com/oceanbase/obshell-sdk-go/sdk/response"
+)
+
+type CreateResourcePoolRequest struct {
+	*request.BaseRequest
+	param CreateResourcePoolParam
+}
+
+type CreateResourcePoolParam struct {
+	Name           string   `json:"name" binding:"required"`
+	UnitConfigName string   `json:"unit_config_name" binding:"required"`
+	UnitNum        int      `json:"unit_num" binding:"required"`  // UnitNum should be greater than 0 and not greater than the server count of each zone.
+	ZoneList       []string `json:"zone_list" binding:"required"` // The zones where the units of the resource pool are placed.
+}
+
+type CreateResourcePoolResponse struct {
+	*response.TaskResponse
+}
+
+func (c *Client) createCreateResourcePoolResponse() *CreateResourcePoolResponse {
+	return &CreateResourcePoolResponse{
+		TaskResponse: response.NewTaskResponse(),
+	}
+}
+
+// NewCreateResourcePoolRequest return a CreateResourcePoolRequest, which can be used as the argument for the CreateResourcePoolWithRequest/CreateResourcePoolSyncWithRequest.
+// poolName: the name of the resource pool.
+// unitConfigName: the name of the resource unit config used by the units of the pool.
+// unitNum: the unit number of each zone.
+// zoneList: the zones where the units of the resource pool are placed.
+// The resource pool is created without any tenant, it can be used by the tenant created later.
+func (c *Client) NewCreateResourcePoolRequest(poolName string, unitConfigName string, unitNum int, zoneList []string) *CreateResourcePoolRequest {
+	req := &CreateResourcePoolRequest{
+		BaseRequest: request.NewAsyncBaseRequest(),
+		param: CreateResourcePoolParam{
+			Name:           poolName,
+			UnitConfigName: unitConfigName,
+			UnitNum:        unitNum,
+			ZoneList:       zoneList,
+		},
+	}
+	req.SetBody(&req.param)
+	req.SetAuthentication()
+	req.InitApiInfo("/api/v1/resource-pool", c.GetHost(), c.GetPort(), "POST")
+	return req
+}
+
+// CreateResourcePool returns a DagDetailDTO and an error, when the task is completed successfully, the error will be nil.
+// poolName: the name of the resource pool.
+// unitConfigName: the name of the resource unit config used by the units of the pool.
+// unitNum: the unit number of each zone.
+// zoneList: the zones where the units of the resource pool are placed.
+func (c *Client) CreateResourcePool(poolName string, unitConfigName string, unitNum int, zoneList []string) (*model.DagDetailDTO, error) {
+	request := c.NewCreateResourcePoolRequest(poolName, unitConfigName, unitNum, zoneList)
+	return c.CreateResourcePoolSyncWithRequest(request)
+}
+
+// CreateResourcePoolWithRequest returns a DagDetailDTO and an error, when the task is requested successfully, the error will be nil.
+// the parameter is a CreateResourcePoolRequest, which can be created by NewCreateResourcePoolRequest.
+// You can use WaitDagSucceed to wait for the task to complete.
+// You can check or operater the task through the DagDetailDTO.
+// If the agent creates the resource pool synchronously, the DagDetailDTO will be nil.
+func (c *Client) CreateResourcePoolWithRequest(request *CreateResourcePoolRequest) (dag *model.DagDetailDTO, err error) {
+	response := c.createCreateResourcePoolResponse()
+	if err = c.Execute(request, response); err != nil {
+		return nil, err
+	}
+	return response.DagDetailDTO, nil
+}
+
+// CreateResourcePoolSyncWithRequest returns a DagDetailDTO and an error, when the task is completed successfully, the error will be nil.
+// the DagDetailDTO is the final status of the task.
+// the parameter is a CreateResourcePoolRequest, which can be created by NewCreateResourcePoolRequest.
+// You can check or operater the task through the DagDetailDTO.
+// If the agent creates the resource pool synchronously, the DagDetailDTO will be nil.
+func (c *Client) CreateResourcePoolSyncWithRequest(request *CreateResourcePoolRequest) (dag *model.DagDetailDTO, err error) {
+	if dag, err = c.CreateResourcePoolWithRequest(request); err != nil {
+		return nil, err
+	}
+	if dag == nil || dag.GenericDTO == nil {
+		return nil, nil
+	}
+	return c.WaitDagSucceed(dag.GenericID)
+}
@@ -0,0 +1,118 @@
+/*
+ * Copyright (c) 2024 OceanBase.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1
+
+import (
+	"github.com/oceanbase/obshell-sdk-go/model"
+	"github.com/oceanbase/obshell-sdk-go/sdk/request"
+	"github.com/oceanbase/obshell-sdk-go/sdk/response"
+)
+
+type CreateStandbyTenantRequest struct {
+	*request.BaseRequest
+	param CreateStandbyTenantParam
+}
+
+type CreateStandbyTenantParam struct {
+	Name          string                        `json:"name" binding:"required"`
+	ZoneList      []ZoneParam                   `json:"zone_list" binding:"required"` // Tenant zone list with unit config.
+	PrimaryZone   *string                       `json:"primary_zone"`
+	PrimaryTenant *model.PrimaryTenantLogSource `json:"primary_tenant"`  // Either PrimaryTenant or ArchiveLogUri should be set.
+	ArchiveLogUri *string                       `json:"archive_log_uri"` // Either PrimaryTenant or ArchiveLogUri should be set.
+	DataBackupUri *string                       `json:"data_backup_uri"` // Restore the standby tenant from the data backup first, optional.
+}
+
+type CreateStandbyTenantResponse struct {
+	*response.TaskResponse
+}
+
+func (c *Client) createCreateStandbyTenantResponse() *CreateStandbyTenantResponse {
+	return &CreateStandbyTenantResponse{
+		TaskResponse: response.NewTaskResponse(),
+	}
+}
+
+// NewCreateStandbyTenantRequest return a CreateStandbyTenantRequest, which can be used as the argument for the CreateStandbyTenantWithRequest/CreateStandbyTenantSyncWithRequest.
+// tenantName: the name of the standby tenant.
+// zoneList: the zone list with replicas properties.
+// You should set the log source by calling SetPrimaryTenant or SetArchiveLogUri.
+func (c *Client) NewCreateStandbyTenantRequest(tenantName string, zoneList []ZoneParam) *CreateStandbyTenantRequest {
+	req := &CreateStandbyTenantRequest{
+		BaseRequest: request.NewAsyncBaseRequest(),
+		param: CreateStandbyTenantParam{
+			Name:     tenantName,
+			ZoneList: zoneList,
+		},
+	}
+	req.SetBody(&req.param)
+	req.SetAuthentication()
+	req.InitApiInfo("/api/v1/tenant/standby", c.GetHost(), c.GetPort(), "POST")
+	return req
+}
+
+// SetPrimaryTenant sets the primary tenant as the log source, the standby tenant will sync logs from it over network.
+func (r *CreateStandbyTenantRequest) SetPrimaryTenant(source model.PrimaryTenantLogSource) *CreateStandbyTenantRequest {
+	r.param.PrimaryTenant = &source
+	r.param.ArchiveLogUri = nil
+	r.SetBody(&r.param)
+	return r
+}
+
+// SetArchiveLogUri sets the archive log of the primary tenant as the log source.
+func (r *CreateStandbyTenantRequest) SetArchiveLogUri(archiveLogUri string) *CreateStandbyTenantRequest {
+	r.param.ArchiveLogUri = &archiveLogUri
+	r.param.PrimaryTenant = nil
+	r.SetBody(&r.param)
+	return r
+}
+
+// SetDataBackupUri sets the data backup of the primary tenant, the standby tenant will be restored from it before syncing logs.
+func (r *CreateStandbyTenantRequest) SetDataBackupUri(dataBackupUri string) *CreateStandbyTenantRequest {
+	r.param.DataBackupUri = &dataBackupUri
+	r.SetBody(&r.param)
+	return r
+}
+
+func (r *CreateStandbyTenantRequest) SetPrimaryZone(primaryZone string) *CreateStandbyTenantRequest {
+	r.param.PrimaryZone = &primaryZone
+	r.SetBody(&r.param)
+	return r
+}
+
+// CreateStandbyTenantWithRequest returns a DagDetailDTO and an error, when the task is requested successfully, the error will be nil.
+// the parameter is a CreateStandbyTenantRequest, which can be created by NewCreateStandbyTenantRequest.
+// You can use WaitDagSucceed to wait for the task to complete.
+// You can check or operater the task through the DagDetailDTO.
+func (c *Client) CreateStandbyTenantWithRequest(request *CreateStandbyTenantRequest) (dag *model.DagDetailDTO, err error) {
+	response := c.createCreateStandbyTenantResponse()
+	if err = c.Execute(request, response); err != nil {
+		return nil, err
+	}
+	return response.DagDetailDTO, nil
+}
+
+// CreateStandbyTenantSyncWithRequest returns a DagDetailDTO and an error, when the task is completed successfully, the error will be nil.
+// the DagDetailDTO is the final status of the task.
+// the parameter is a CreateStandbyTenantRequest, which can be created by NewCreateStandbyTenantRequest.
+// You can check or operater the task through the DagDetailDTO.
+func (c *Client) CreateStandbyTenantSyncWithRequest(request *CreateStandbyTenantRequest) (*model.DagDetailDTO, error) {
+	dag, err := c.CreateStandbyTenantWithRequest(request)
+	if err != nil {
+		return nil, err
+	}
+	return c.WaitDagSucceed(dag.GenericID)
+}
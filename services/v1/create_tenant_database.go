@@ -0,0 +1,124 @@
+/*
+ * Copyright (c) 2024 OceanBase.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1
+
+import (
+	"fmt"
+
+	"github.com/oceanbase/obshell-sdk-go/model"
+	"github.com/oceanbase/obshell-sdk-go/sdk/request"
+	"github.com/oceanbase/obshell-sdk-go/sdk/response"
+)
+
+type CreateTenantDatabaseRequest struct {
+	*request.BaseRequest
+	param CreateTenantDatabaseParam
+}
+
+type CreateTenantDatabaseParam struct {
+	DbName    string  `json:"db_name" binding:"required"`
+	Charset   *string `json:"charset"`   // optional
+	Collation *string `json:"collation"` // optional
+	ReadOnly  bool    `json:"read_only"` // Default to false.
+	Comment   *string `json:"comment"`   // optional
+}
+
+type CreateTenantDatabaseResponse struct {
+	*response.TaskResponse
+}
+
+func (c *Client) createCreateTenantDatabaseResponse() *CreateTenantDatabaseResponse {
+	return &CreateTenantDatabaseResponse{
+		TaskResponse: response.NewTaskResponse(),
+	}
+}
+
+// NewCreateTenantDatabaseRequest return a CreateTenantDatabaseRequest, which can be used as the argument for the CreateTenantDatabaseWithRequest/CreateTenantDatabaseSyncWithRequest.
+// tenantName: the name of the tenant.
+// dbName: the name of the database.
+// You can set the charset, collation, read only and comment by calling SetCharset, SetCollation, SetReadOnly and SetComment.
+func (c *Client) NewCreateTenantDatabaseRequest(tenantName string, dbName string) *CreateTenantDatabaseRequest {
+	req := &CreateTenantDatabaseRequest{
+		BaseRequest: request.NewAsyncBaseRequest(),
+		param: CreateTenantDatabaseParam{
+			DbName: dbName,
+		},
+	}
+	req.SetBody(&req.param)
+	req.SetAuthentication()
+	req.InitApiInfo(fmt.Sprintf("/api/v1/tenant/%s/database", tenantName), c.GetHost(), c.GetPort(), "POST")
+	return req
+}
+
+func (r *CreateTenantDatabaseRequest) SetCharset(charset string) *CreateTenantDatabaseRequest {
+	r.param.Charset = &charset
+	r.SetBody(&r.param)
+	return r
+}
+
+func (r *CreateTenantDatabaseRequest) SetCollation(collation string) *CreateTenantDatabaseRequest {
+	r.param.Collation = &collation
+	r.SetBody(&r.param)
+	return r
+}
+
+func (r *CreateTenantDatabaseRequest) SetReadOnly(readOnly bool) *CreateTenantDatabaseRequest {
+	r.param.ReadOnly = readOnly
+	r.SetBody(&r.param)
+	return r
+}
+
+func (r *CreateTenantDatabaseRequest) SetComment(comment string) *CreateTenantDatabaseRequest {
+	r.param.Comment = &comment
+	r.SetBody(&r.param)
+	return r
+}
+
+// CreateTenantDatabase returns a DagDetailDTO and an error, when the task is completed successfully, the error will be nil.
+// tenantName: the name of the tenant.
+// dbName: the name of the database.
+func (c *Client) CreateTenantDatabase(tenantName string, dbName string) (*model.DagDetailDTO, error) {
+	request := c.NewCreateTenantDatabaseRequest(tenantName, dbName)
+	return c.CreateTenantDatabaseSyncWithRequest(request)
+}
+
+// CreateTenantDatabaseWithRequest returns a DagDetailDTO and an error, when the task is requested successfully, the error will be nil.
+// the parameter is a CreateTenantDatabaseRequest, which can be created by NewCreateTenantDatabaseRequest.
+// You can use WaitDagSucceed to wait for the task to complete.
+// You can check or operater the task through the DagDetailDTO.
+func (c *Client) CreateTenantDatabaseWithRequest(request *CreateTenantDatabaseRequest) (dag *model.DagDetailDTO, err error) {
+	response := c.createCreateTenantDatabaseResponse()
+	if err = c.Execute(request, response); err != nil {
+		return nil, err
+	}
+	return response.DagDetailDTO, nil
+}
+
+// CreateTenantDatabaseSyncWithRequest returns a DagDetailDTO and an error, when the task is completed successfully, the error will be nil.
+// the DagDetailDTO is the final status of the task.
+// the parameter is a CreateTenantDatabaseRequest, which can be created by NewCreateTenantDatabaseRequest.
+// You can check or operater the task through the DagDetailDTO.
+// If the database is created synchronously by the agent, the DagDetailDTO will be nil.
+func (c *Client) CreateTenantDatabaseSyncWithRequest(request *CreateTenantDatabaseRequest) (dag *model.DagDetailDTO, err error) {
+	if dag, err = c.CreateTenantDatabaseWithRequest(request); err != nil {
+		return nil, err
+	}
+	if dag == nil || dag.GenericDTO == nil {
+		return nil, nil
+	}
+	return c.WaitDagSucceed(dag.GenericID)
+}
@@ -0,0 +1,103 @@
+/*
+ * Copyright (c) 2024 OceanBase.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1
+
+import (
+	"fmt"
+
+	"github.com/oceanbase/obshell-sdk-go/model"
+	"github.com/oceanbase/obshell-sdk-go/sdk/request"
+	"github.com/oceanbase/obshell-sdk-go/sdk/response"
+)
+
+type CreateTenantUserRequest struct {
+	*request.BaseRequest
+	param CreateTenantUserParam
+}
+
+type CreateTenantUserParam struct {
+	UserName         string              `json:"user_name" binding:"required"`
+	Password         string              `json:"password" binding:"required"`
+	HostName         string              `json:"host_name"`         // Default to '%'.
+	GlobalPrivileges []string            `json:"global_privileges"` // optional
+	DbPrivileges     []model.DbPrivilege `json:"db_privileges"`     // optional
+}
+
+type createTenantUserResponse struct {
+	*response.OcsAgentResponse
+}
+
+func (c *Client) createCreateTenantUserResponse() *createTenantUserResponse {
+	return &createTenantUserResponse{
+		OcsAgentResponse: response.NewOcsAgentResponseWithoutReturn(),
+	}
+}
+
+// NewCreateTenantUserRequest return a CreateTenantUserRequest, which can be used as the argument for the CreateTenantUserWithRequest.
+// tenantName: the name of the tenant.
+// userName: the name of the user.
+// password: the password of the user, it will be encrypted with the request body as SetTenantRootPassword.
+// You can set the host name and privileges by calling SetHostName, SetGlobalPrivileges and SetDbPrivileges.
+func (c *Client) NewCreateTenantUserRequest(tenantName string, userName string, password string) *CreateTenantUserRequest {
+	req := &CreateTenantUserRequest{
+		BaseRequest: request.NewBaseRequest(),
+		param: CreateTenantUserParam{
+			UserName: userName,
+			Password: password,
+		},
+	}
+	req.SetBody(&req.param)
+	req.SetAuthentication()
+	req.InitApiInfo(fmt.Sprintf("/api/v1/tenant/%s/user", tenantName), c.GetHost(), c.GetPort(), "POST")
+	return req
+}
+
+// SetHostName sets the host from which the user can connect, default to '%'.
+func (r *CreateTenantUserRequest) SetHostName(hostName string) *CreateTenantUserRequest {
+	r.param.HostName = hostName
+	r.SetBody(&r.param)
+	return r
+}
+
+// SetGlobalPrivileges sets the global privileges of the user, such as "SELECT" and "CREATE".
+func (r *CreateTenantUserRequest) SetGlobalPrivileges(privileges []string) *CreateTenantUserRequest {
+	r.param.GlobalPrivileges = privileges
+	r.SetBody(&r.param)
+	return r
+}
+
+// SetDbPrivileges sets the database-level privileges of the user.
+func (r *CreateTenantUserRequest) SetDbPrivileges(privileges []model.DbPrivilege) *CreateTenantUserRequest {
+	r.param.DbPrivileges = privileges
+	r.SetBody(&r.param)
+	return r
+}
+
+// CreateTenantUser creates a user in the tenant.
+// tenantName: the name of the tenant.
+// userName: the name of the user.
+// password: the password of the user.
+func (c *Client) CreateTenantUser(tenantName string, userName string, password string) error {
+	request := c.NewCreateTenantUserRequest(tenantName, userName, password)
+	return c.CreateTenantUserWithRequest(request)
+}
+
+// CreateTenantUserWithRequest creates a user in the tenant with a CreateTenantUserRequest.
+func (c *Client) CreateTenantUserWithRequest(request *CreateTenantUserRequest) error {
+	response := c.createCreateTenantUserResponse()
+	return c.Execute(request, response)
+}
@@ -26,6 +26,7 @@ import (
 
 type DeleteZoneRequest struct {
 	*request.BaseRequest
+	zoneName string
 }
 
 type deleteZoneResponse struct {
@@ -43,6 +44,7 @@ func (c *Client) createDeleteZoneResponse() *deleteZoneResponse {
 func (c *Client) NewDeleteZoneRequest(zoneName string) *DeleteZoneRequest {
 	req := &DeleteZoneRequest{
 		BaseRequest: request.NewBaseRequest(),
+		zoneName:    zoneName,
 	}
 	req.SetAuthentication()
 	req.InitApiInfo(fmt.Sprintf("/api/v1/zone/%s", zoneName), c.GetHost(), c.GetPort(), "DELETE")
@@ -59,6 +61,9 @@ func (c *Client) DeleteZone(zoneName string) (dag *model.DagDetailDTO, err error
 // You can use WaitDagSucceed to wait for the task to complete.
 // You can check or operater the task through the DagDetailDTO.
 func (c *Client) DeleteZoneWithRequest(request *DeleteZoneRequest) (dag *model.DagDetailDTO, err error) {
+	if err = c.checkDeleteZoneGuardrail(request); err != nil {
+		return nil, err
+	}
 	response := c.createDeleteZoneResponse()
 	if err = c.Execute(request, response); err != nil {
 		return nil, err
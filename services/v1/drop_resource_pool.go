@@ -25,6 +25,7 @@ import (
 
 type DropResourcePoolRequest struct {
 	*request.BaseRequest
+	poolName string
 }
 
 type DropResourcePoolResponse struct {
@@ -42,6 +43,7 @@ func (c *Client) createDropResourcePoolResponse() *DropResourcePoolResponse {
 func (c *Client) NewDropResourcePoolRequest(poolName string) *DropResourcePoolRequest {
 	req := &DropResourcePoolRequest{
 		BaseRequest: request.NewBaseRequest(),
+		poolName:    poolName,
 	}
 	req.SetAuthentication()
 	req.InitApiInfo(fmt.Sprintf("/api/v1/resource-pool/%s", poolName), c.GetHost(), c.GetPort(), "DELETE")
@@ -57,6 +59,9 @@ func (c *Client) DropResourcePool(poolName string) error {
 
 // DropResourcePoolWithRequest drops a resource pool with a DropResourcePoolRequest.
 func (c *Client) DropResourcePoolWithRequest(request *DropResourcePoolRequest) error {
+	if err := c.checkDropResourcePoolGuardrail(request); err != nil {
+		return err
+	}
 	response := c.createDropResourcePoolResponse()
 	return c.Execute(request, response)
 }
@@ -26,7 +26,8 @@ import (
 
 type DropTenantRequest struct {
 	*request.BaseRequest
-	param DropTenantParam
+	param      DropTenantParam
+	tenantName string
 }
 
 type DropTenantParam struct {
@@ -52,6 +53,7 @@ func (c *Client) NewDropTenantRequest(tenantName string) *DropTenantRequest {
 		param: DropTenantParam{
 			NeedRecycle: false,
 		},
+		tenantName: tenantName,
 	}
 	req.SetBody(&req.param)
 	req.SetAuthentication()
@@ -81,6 +83,9 @@ func (c *Client) DropTenant(tenantName string) (*model.DagDetailDTO, error) {
 // You can check or operater the task through the DagDetailDTO.
 // If the tenant does not exist, the DagDetailDTO will be nil.
 func (c *Client) DropTenantWithRequest(request *DropTenantRequest) (dag *model.DagDetailDTO, err error) {
+	if err = c.checkDropTenantGuardrail(request); err != nil {
+		return nil, err
+	}
 	response := c.createDropTenantResponse()
 	if err = c.Execute(request, response); err != nil {
 		return nil, err
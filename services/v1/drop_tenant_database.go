@@ -0,0 +1,91 @@
+/*
+ * Copyright (c) 2024 OceanBase.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1
+
+import (
+	"fmt"
+
+	"github.com/oceanbase/obshell-sdk-go/model"
+	"github.com/oceanbase/obshell-sdk-go/sdk/request"
+	"github.com/oceanbase/obshell-sdk-go/sdk/response"
+)
+
+type DropTenantDatabaseRequest struct {
+	*request.BaseRequest
+	tenantName string
+}
+
+type DropTenantDatabaseResponse struct {
+	*response.TaskResponse
+}
+
+func (c *Client) createDropTenantDatabaseResponse() *DropTenantDatabaseResponse {
+	return &DropTenantDatabaseResponse{
+		TaskResponse: response.NewTaskResponse(),
+	}
+}
+
+// NewDropTenantDatabaseRequest return a DropTenantDatabaseRequest, which can be used as the argument for the DropTenantDatabaseWithRequest/DropTenantDatabaseSyncWithRequest.
+// tenantName: the name of the tenant.
+// dbName: the name of the database.
+func (c *Client) NewDropTenantDatabaseRequest(tenantName string, dbName string) *DropTenantDatabaseRequest {
+	req := &DropTenantDatabaseRequest{
+		BaseRequest: request.NewAsyncBaseRequest(),
+		tenantName:  tenantName,
+	}
+	req.SetAuthentication()
+	req.InitApiInfo(fmt.Sprintf("/api/v1/tenant/%s/database/%s", tenantName, dbName), c.GetHost(), c.GetPort(), "DELETE")
+	return req
+}
+
+// DropTenantDatabase returns a DagDetailDTO and an error, when the task is completed successfully, the error will be nil.
+// tenantName: the name of the tenant.
+// dbName: the name of the database.
+func (c *Client) DropTenantDatabase(tenantName string, dbName string) (*model.DagDetailDTO, error) {
+	request := c.NewDropTenantDatabaseRequest(tenantName, dbName)
+	return c.DropTenantDatabaseSyncWithRequest(request)
+}
+
+// DropTenantDatabaseWithRequest returns a DagDetailDTO and an error, when the task is requested successfully, the error will be nil.
+// the parameter is a DropTenantDatabaseRequest, which can be created by NewDropTenantDatabaseRequest.
+// You can use WaitDagSucceed to wait for the task to complete.
+// You can check or operater the task through the DagDetailDTO.
+func (c *Client) DropTenantDatabaseWithRequest(request *DropTenantDatabaseRequest) (dag *model.DagDetailDTO, err error) {
+	if err = c.checkDropTenantDatabaseGuardrail(request); err != nil {
+		return nil, err
+	}
+	response := c.createDropTenantDatabaseResponse()
+	if err = c.Execute(request, response); err != nil {
+		return nil, err
+	}
+	return response.DagDetailDTO, nil
+}
+
+// DropTenantDatabaseSyncWithRequest returns a DagDetailDTO and an error, when the task is completed successfully, the error will be nil.
+// the DagDetailDTO is the final status of the task.
+// the parameter is a DropTenantDatabaseRequest, which can be created by NewDropTenantDatabaseRequest.
+// You can check or operater the task through the DagDetailDTO.
+// If the database does not exist, the DagDetailDTO will be nil.
+func (c *Client) DropTenantDatabaseSyncWithRequest(request *DropTenantDatabaseRequest) (dag *model.DagDetailDTO, err error) {
+	if dag, err = c.DropTenantDatabaseWithRequest(request); err != nil {
+		return nil, err
+	}
+	if dag == nil || dag.GenericDTO == nil {
+		return nil, nil
+	}
+	return c.WaitDagSucceed(dag.GenericID)
+}
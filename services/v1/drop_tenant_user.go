@@ -0,0 +1,67 @@
+/*
+ * Copyright (c) 2024 OceanBase.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1
+
+import (
+	"fmt"
+
+	"github.com/oceanbase/obshell-sdk-go/sdk/request"
+	"github.com/oceanbase/obshell-sdk-go/sdk/response"
+)
+
+type DropTenantUserRequest struct {
+	*request.BaseRequest
+	tenantName string
+}
+
+type dropTenantUserResponse struct {
+	*response.OcsAgentResponse
+}
+
+func (c *Client) createDropTenantUserResponse() *dropTenantUserResponse {
+	return &dropTenantUserResponse{
+		OcsAgentResponse: response.NewOcsAgentResponseWithoutReturn(),
+	}
+}
+
+// NewDropTenantUserRequest return a DropTenantUserRequest, which can be used as the argument for the DropTenantUserWithRequest.
+// tenantName: the name of the tenant.
+// userName: the name of the user, could not be root.
+func (c *Client) NewDropTenantUserRequest(tenantName string, userName string) *DropTenantUserRequest {
+	req := &DropTenantUserRequest{
+		BaseRequest: request.NewBaseRequest(),
+		tenantName:  tenantName,
+	}
+	req.SetAuthentication()
+	req.InitApiInfo(fmt.Sprintf("/api/v1/tenant/%s/user/%s", tenantName, userName), c.GetHost(), c.GetPort(), "DELETE")
+	return req
+}
+
+// DropTenantUser drops a user of the tenant.
+func (c *Client) DropTenantUser(tenantName string, userName string) error {
+	request := c.NewDropTenantUserRequest(tenantName, userName)
+	return c.DropTenantUserWithRequest(request)
+}
+
+// DropTenantUserWithRequest drops a user of the tenant with a DropTenantUserRequest.
+func (c *Client) DropTenantUserWithRequest(request *DropTenantUserRequest) error {
+	if err := c.checkDropTenantUserGuardrail(request); err != nil {
+		return err
+	}
+	response := c.createDropTenantUserResponse()
+	return c.Execute(request, response)
+}
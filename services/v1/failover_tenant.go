@@ -0,0 +1,83 @@
+/*
+ * Copyright (c) 2024 OceanBase.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1
+
+import (
+	"fmt"
+
+	"github.com/oceanbase/obshell-sdk-go/model"
+	"github.com/oceanbase/obshell-sdk-go/sdk/request"
+	"github.com/oceanbase/obshell-sdk-go/sdk/response"
+)
+
+type FailoverTenantRequest struct {
+	*request.BaseRequest
+}
+
+type FailoverTenantResponse struct {
+	*response.TaskResponse
+}
+
+func (c *Client) createFailoverTenantResponse() *FailoverTenantResponse {
+	return &FailoverTenantResponse{
+		TaskResponse: response.NewTaskResponse(),
+	}
+}
+
+// NewFailoverTenantRequest return a FailoverTenantRequest, which can be used as the argument for the FailoverTenantWithRequest/FailoverTenantSyncWithRequest.
+// tenantName: the name of the standby tenant.
+// The standby tenant will replay all the logs available from the log source, and then be activated as primary.
+// It is used when the primary tenant is unavailable, the logs not synced from the primary tenant will be lost.
+func (c *Client) NewFailoverTenantRequest(tenantName string) *FailoverTenantRequest {
+	req := &FailoverTenantRequest{
+		BaseRequest: request.NewAsyncBaseRequest(),
+	}
+	req.SetAuthentication()
+	req.InitApiInfo(fmt.Sprintf("/api/v1/tenant/%s/failover", tenantName), c.GetHost(), c.GetPort(), "POST")
+	return req
+}
+
+// FailoverTenant returns a DagDetailDTO and an error, when the task is completed successfully, the error will be nil.
+// tenantName: the name of the standby tenant.
+func (c *Client) FailoverTenant(tenantName string) (*model.DagDetailDTO, error) {
+	request := c.NewFailoverTenantRequest(tenantName)
+	return c.FailoverTenantSyncWithRequest(request)
+}
+
+// FailoverTenantWithRequest returns a DagDetailDTO and an error, when the task is requested successfully, the error will be nil.
+// the parameter is a FailoverTenantRequest, which can be created by NewFailoverTenantRequest.
+// You can use WaitDagSucceed to wait for the task to complete.
+// You can check or operater the task through the DagDetailDTO.
+func (c *Client) FailoverTenantWithRequest(request *FailoverTenantRequest) (dag *model.DagDetailDTO, err error) {
+	response := c.createFailoverTenantResponse()
+	if err = c.Execute(request, response); err != nil {
+		return nil, err
+	}
+	return response.DagDetailDTO, nil
+}
+
+// FailoverTenantSyncWithRequest returns a DagDetailDTO and an error, when the task is completed successfully, the error will be nil.
+// the DagDetailDTO is the final status of the task.
+// the parameter is a FailoverTenantRequest, which can be created by NewFailoverTenantRequest.
+// You can check or operater the task through the DagDetailDTO.
+func (c *Client) FailoverTenantSyncWithRequest(request *FailoverTenantRequest) (*model.DagDetailDTO, error) {
+	dag, err := c.FailoverTenantWithRequest(request)
+	if err != nil {
+		return nil, err
+	}
+	return c.WaitDagSucceed(dag.GenericID)
+}
@@ -0,0 +1,72 @@
+/*
+ * Copyright (c) 2024 OceanBase.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1
+
+import (
+	"github.com/oceanbase/obshell-sdk-go/model"
+	"github.com/oceanbase/obshell-sdk-go/sdk/request"
+	"github.com/oceanbase/obshell-sdk-go/sdk/response"
+)
+
+type GetAllTenantResourceStatsRequest struct {
+	*request.BaseRequest
+}
+
+// NewGetAllTenantResourceStatsRequest return a GetAllTenantResourceStatsRequest, which can be used as the argument for the GetAllTenantResourceStatsWithRequest.
+func (c *Client) NewGetAllTenantResourceStatsRequest() *GetAllTenantResourceStatsRequest {
+	req := &GetAllTenantResourceStatsRequest{
+		BaseRequest: request.NewBaseRequest(),
+	}
+	req.InitApiInfo("/api/v1/tenants/resource-stats", c.GetHost(), c.GetPort(), "GET")
+	req.SetAuthentication()
+	return req
+}
+
+type getAllTenantResourceStatsResponse struct {
+	*response.OcsAgentResponse
+	*IteratorTenantResourceStats
+}
+
+type IteratorTenantResourceStats struct {
+	Stats []model.TenantResourceStats `json:"contents"`
+}
+
+func (c *Client) createGetAllTenantResourceStatsResponse() *getAllTenantResourceStatsResponse {
+	resp := &getAllTenantResourceStatsResponse{
+		OcsAgentResponse:            response.NewOcsAgentResponse(),
+		IteratorTenantResourceStats: &IteratorTenantResourceStats{},
+	}
+	resp.Data = resp.IteratorTenantResourceStats
+	return resp
+}
+
+// GetAllTenantResourceStats returns a []TenantResourceStats and an error.
+// If the error is non-nil, the []TenantResourceStats will be empty.
+func (c *Client) GetAllTenantResourceStats() (stats []model.TenantResourceStats, err error) {
+	req := c.NewGetAllTenantResourceStatsRequest()
+	return c.GetAllTenantResourceStatsWithRequest(req)
+}
+
+// GetAllTenantResourceStatsWithRequest returns a []TenantResourceStats and an error.
+// If the error is non-nil, the []TenantResourceStats will be empty.
+func (c *Client) GetAllTenantResourceStatsWithRequest(req *GetAllTenantResourceStatsRequest) (stats []model.TenantResourceStats, err error) {
+	response := c.createGetAllTenantResourceStatsResponse()
+	if err = c.Execute(req, response); err != nil {
+		return nil, err
+	}
+	return response.Stats, err
+}
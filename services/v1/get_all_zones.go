@@ -0,0 +1,70 @@
+/*
+ * Copyright (c) 2024 OceanBase.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1
+
+import (
+	"github.com/oceanbase/obshell-sdk-go/model"
+	"github.com/oceanbase/obshell-sdk-go/sdk/request"
+	"github.com/oceanbase/obshell-sdk-go/sdk/response"
+)
+
+type GetAllZonesRequest struct {
+	*request.BaseRequest
+}
+
+type IterableZoneInfo struct {
+	Contents []*model.ZoneInfo `json:"contents"`
+}
+
+type getAllZonesResponse struct {
+	*response.OcsAgentResponse
+	*IterableZoneInfo
+}
+
+func (c *Client) createGetAllZonesResponse() *getAllZonesResponse {
+	resp := &getAllZonesResponse{
+		OcsAgentResponse: response.NewOcsAgentResponse(),
+		IterableZoneInfo: &IterableZoneInfo{},
+	}
+	resp.Data = resp.IterableZoneInfo
+	return resp
+}
+
+// NewGetAllZonesRequest returns a GetAllZonesRequest, which can be used as the argument for the GetAllZonesWithRequest.
+func (c *Client) NewGetAllZonesRequest() *GetAllZonesRequest {
+	req := &GetAllZonesRequest{
+		BaseRequest: request.NewBaseRequest(),
+	}
+	req.SetAuthentication()
+	req.InitApiInfo("/api/v1/zones", c.GetHost(), c.GetPort(), "GET")
+	return req
+}
+
+// GetAllZones returns the status of all the zones in cluster.
+func (c *Client) GetAllZones() ([]*model.ZoneInfo, error) {
+	request := c.NewGetAllZonesRequest()
+	return c.GetAllZonesWithRequest(request)
+}
+
+// GetAllZonesWithRequest returns the status of all the zones with a GetAllZonesRequest.
+func (c *Client) GetAllZonesWithRequest(request *GetAllZonesRequest) ([]*model.ZoneInfo, error) {
+	response := c.createGetAllZonesResponse()
+	if err := c.Execute(request, response); err != nil {
+		return nil, err
+	}
+	return response.Contents, nil
+}
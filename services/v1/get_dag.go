@@ -85,6 +85,7 @@ func (c *Client) GetDagWithRequest(req *GetDagRequest) (dag *model.DagDetailDTO,
 // When query dag failed, the error will be wrapped with v1.ErrQueryDagFailed.
 // Return err once a query failed
 func (c *Client) WaitDagSucceed(dagId string) (dag *model.DagDetailDTO, err error) {
+	defer func() { c.AuditDag(dagId, dag, err) }()
 	for {
 		dag, err = c.GetDag(dagId)
 		if err != nil {
@@ -106,6 +107,7 @@ func (c *Client) WaitDagSucceed(dagId string) (dag *model.DagDetailDTO, err erro
 // When query dag failed, WaitDagSucceedWithRetry will retry until the dag is finished or the retry times has reached the limit.
 // When query dag failed, the error will be wrapped with v1.ErrQueryDagFailed.
 func (c *Client) WaitDagSucceedWithRetry(dagId string, retryTimes int) (dag *model.DagDetailDTO, err error) {
+	defer func() { c.AuditDag(dagId, dag, err) }()
 	for {
 		dag, err = c.GetDag(dagId)
 		if err != nil {
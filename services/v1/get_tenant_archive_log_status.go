@@ -0,0 +1,78 @@
+/*
+ * Copyright (c) 2024 OceanBase.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/oceanbase/obshell-sdk-go/model"
+	"github.com/oceanbase/obshell-sdk-go/sdk/request"
+	"github.com/oceanbase/obshell-sdk-go/sdk/response"
+)
+
+var ErrArchiveLogNotConfigured = errors.New("log archive is not configured")
+
+type GetTenantArchiveLogStatusRequest struct {
+	*request.BaseRequest
+}
+
+type getTenantArchiveLogStatusResponse struct {
+	*response.OcsAgentResponse
+	*model.ArchiveLogStatus
+}
+
+func (c *Client) createGetTenantArchiveLogStatusResponse() *getTenantArchiveLogStatusResponse {
+	resp := &getTenantArchiveLogStatusResponse{
+		OcsAgentResponse: response.NewOcsAgentResponse(),
+		ArchiveLogStatus: &model.ArchiveLogStatus{},
+	}
+	resp.Data = resp.ArchiveLogStatus
+	return resp
+}
+
+// NewGetTenantArchiveLogStatusRequest return a GetTenantArchiveLogStatusRequest, which can be used as the argument for the GetTenantArchiveLogStatusWithRequest.
+// tenantName: the name of the tenant.
+func (c *Client) NewGetTenantArchiveLogStatusRequest(tenantName string) *GetTenantArchiveLogStatusRequest {
+	req := &GetTenantArchiveLogStatusRequest{
+		BaseRequest: request.NewBaseRequest(),
+	}
+	req.SetAuthentication()
+	req.InitApiInfo(fmt.Sprintf("/api/v1/tenant/%s/backup/log", tenantName), c.GetHost(), c.GetPort(), "GET")
+	return req
+}
+
+// GetTenantArchiveLogStatus returns the status of the log archive of the tenant.
+// tenantName: the name of the tenant.
+func (c *Client) GetTenantArchiveLogStatus(tenantName string) (*model.ArchiveLogStatus, error) {
+	req := c.NewGetTenantArchiveLogStatusRequest(tenantName)
+	return c.GetTenantArchiveLogStatusWithRequest(req)
+}
+
+// GetTenantArchiveLogStatusWithRequest returns the status of the log archive with a GetTenantArchiveLogStatusRequest.
+// If the log archive of the tenant is not configured, the error will be ErrArchiveLogNotConfigured.
+func (c *Client) GetTenantArchiveLogStatusWithRequest(req *GetTenantArchiveLogStatusRequest) (*model.ArchiveLogStatus, error) {
+	response := c.createGetTenantArchiveLogStatusResponse()
+	if err := c.Execute(req, response); err != nil {
+		return nil, err
+	}
+	if response.ArchiveLogStatus.Status == "" {
+		return nil, ErrArchiveLogNotConfigured
+	}
+	return response.ArchiveLogStatus, nil
+}
@@ -0,0 +1,76 @@
+/*
+ * Copyright (c) 2024 OceanBase.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1
+
+import (
+	"fmt"
+
+	"github.com/oceanbase/obshell-sdk-go/model"
+	"github.com/oceanbase/obshell-sdk-go/sdk/request"
+	"github.com/oceanbase/obshell-sdk-go/sdk/response"
+)
+
+type GetTenantDatabasesRequest struct {
+	*request.BaseRequest
+}
+
+// NewGetTenantDatabasesRequest return a GetTenantDatabasesRequest, which can be used as the argument for the GetTenantDatabasesWithRequest.
+// tenantName: the name of the tenant.
+func (c *Client) NewGetTenantDatabasesRequest(tenantName string) *GetTenantDatabasesRequest {
+	req := &GetTenantDatabasesRequest{
+		BaseRequest: request.NewBaseRequest(),
+	}
+	req.InitApiInfo(fmt.Sprintf("/api/v1/tenant/%s/databases", tenantName), c.GetHost(), c.GetPort(), "GET")
+	req.SetAuthentication()
+	return req
+}
+
+type IterableTenantDatabases struct {
+	Contents []model.TenantDatabase `json:"contents"`
+}
+
+type getTenantDatabasesResponse struct {
+	*response.OcsAgentResponse
+	*IterableTenantDatabases
+}
+
+func (c *Client) createGetTenantDatabasesResponse() *getTenantDatabasesResponse {
+	resp := &getTenantDatabasesResponse{
+		OcsAgentResponse:        response.NewOcsAgentResponse(),
+		IterableTenantDatabases: &IterableTenantDatabases{},
+	}
+	resp.Data = resp.IterableTenantDatabases
+	return resp
+}
+
+// GetTenantDatabases returns a []TenantDatabase with the size information and an error.
+// If the error is non-nil, the []TenantDatabase will be empty.
+// tenantName: the name of the tenant.
+func (c *Client) GetTenantDatabases(tenantName string) (databases []model.TenantDatabase, err error) {
+	req := c.NewGetTenantDatabasesRequest(tenantName)
+	return c.GetTenantDatabasesWithRequest(req)
+}
+
+// GetTenantDatabasesWithRequest returns a []TenantDatabase and an error.
+// If the error is non-nil, the []TenantDatabase will be empty.
+func (c *Client) GetTenantDatabasesWithRequest(req *GetTenantDatabasesRequest) (databases []model.TenantDatabase, err error) {
+	response := c.createGetTenantDatabasesResponse()
+	if err = c.Execute(req, response); err != nil {
+		return nil, err
+	}
+	return response.Contents, err
+}
@@ -0,0 +1,68 @@
+/*
+ * Copyright (c) 2024 OceanBase.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1
+
+import (
+	"fmt"
+
+	"github.com/oceanbase/obshell-sdk-go/model"
+	"github.com/oceanbase/obshell-sdk-go/sdk/request"
+	"github.com/oceanbase/obshell-sdk-go/sdk/response"
+)
+
+type GetTenantResourceStatsRequest struct {
+	*request.BaseRequest
+}
+
+// NewGetTenantResourceStatsRequest return a GetTenantResourceStatsRequest, which can be used as the argument for the GetTenantResourceStatsWithRequest.
+func (c *Client) NewGetTenantResourceStatsRequest(tenantName string) *GetTenantResourceStatsRequest {
+	req := &GetTenantResourceStatsRequest{
+		BaseRequest: request.NewBaseRequest(),
+	}
+	req.InitApiInfo(fmt.Sprintf("/api/v1/tenant/%s/resource-stats", tenantName), c.GetHost(), c.GetPort(), "GET")
+	req.SetAuthentication()
+	return req
+}
+
+type getTenantResourceStatsResponse struct {
+	*response.OcsAgentResponse
+	*model.TenantResourceStats
+}
+
+func (c *Client) createGetTenantResourceStatsResponse() *getTenantResourceStatsResponse {
+	resp := &getTenantResourceStatsResponse{
+		OcsAgentResponse:    response.NewOcsAgentResponse(),
+		TenantResourceStats: &model.TenantResourceStats{},
+	}
+	resp.Data = resp.TenantResourceStats
+	return resp
+}
+
+// GetTenantResourceStats returns a *TenantResourceStats with the resource usage of the tenant and its units, and an error.
+func (c *Client) GetTenantResourceStats(tenantName string) (stats *model.TenantResourceStats, err error) {
+	req := c.NewGetTenantResourceStatsRequest(tenantName)
+	return c.GetTenantResourceStatsWithRequest(req)
+}
+
+// GetTenantResourceStatsWithRequest returns a *TenantResourceStats and an error.
+func (c *Client) GetTenantResourceStatsWithRequest(req *GetTenantResourceStatsRequest) (stats *model.TenantResourceStats, err error) {
+	response := c.createGetTenantResourceStatsResponse()
+	if err = c.Execute(req, response); err != nil {
+		return nil, err
+	}
+	return response.TenantResourceStats, err
+}
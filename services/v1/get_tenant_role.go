@@ -0,0 +1,68 @@
+/*
+ * Copyright (c) 2024 OceanBase.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1
+
+import (
+	"fmt"
+
+	"github.com/oceanbase/obshell-sdk-go/model"
+	"github.com/oceanbase/obshell-sdk-go/sdk/request"
+	"github.com/oceanbase/obshell-sdk-go/sdk/response"
+)
+
+type GetTenantRoleRequest struct {
+	*request.BaseRequest
+}
+
+// NewGetTenantRoleRequest return a GetTenantRoleRequest, which can be used as the argument for the GetTenantRoleWithRequest.
+func (c *Client) NewGetTenantRoleRequest(tenantName string) *GetTenantRoleRequest {
+	req := &GetTenantRoleRequest{
+		BaseRequest: request.NewBaseRequest(),
+	}
+	req.InitApiInfo(fmt.Sprintf("/api/v1/tenant/%s/role", tenantName), c.GetHost(), c.GetPort(), "GET")
+	req.SetAuthentication()
+	return req
+}
+
+type getTenantRoleResponse struct {
+	*response.OcsAgentResponse
+	*model.TenantRoleInfo
+}
+
+func (c *Client) createGetTenantRoleResponse() *getTenantRoleResponse {
+	resp := &getTenantRoleResponse{
+		OcsAgentResponse: response.NewOcsAgentResponse(),
+		TenantRoleInfo:   &model.TenantRoleInfo{},
+	}
+	resp.Data = resp.TenantRoleInfo
+	return resp
+}
+
+// GetTenantRole returns a *TenantRoleInfo with the role and log sync status of the tenant and an error.
+func (c *Client) GetTenantRole(tenantName string) (role *model.TenantRoleInfo, err error) {
+	req := c.NewGetTenantRoleRequest(tenantName)
+	return c.GetTenantRoleWithRequest(req)
+}
+
+// GetTenantRoleWithRequest returns a *TenantRoleInfo and an error.
+func (c *Client) GetTenantRoleWithRequest(req *GetTenantRoleRequest) (role *model.TenantRoleInfo, err error) {
+	response := c.createGetTenantRoleResponse()
+	if err = c.Execute(req, response); err != nil {
+		return nil, err
+	}
+	return response.TenantRoleInfo, err
+}
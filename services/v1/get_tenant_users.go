@@ -0,0 +1,76 @@
+/*
+ * Copyright (c) 2024 OceanBase.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1
+
+import (
+	"fmt"
+
+	"github.com/oceanbase/obshell-sdk-go/model"
+	"github.com/oceanbase/obshell-sdk-go/sdk/request"
+	"github.com/oceanbase/obshell-sdk-go/sdk/response"
+)
+
+type GetTenantUsersRequest struct {
+	*request.BaseRequest
+}
+
+// NewGetTenantUsersRequest return a GetTenantUsersRequest, which can be used as the argument for the GetTenantUsersWithRequest.
+// tenantName: the name of the tenant.
+func (c *Client) NewGetTenantUsersRequest(tenantName string) *GetTenantUsersRequest {
+	req := &GetTenantUsersRequest{
+		BaseRequest: request.NewBaseRequest(),
+	}
+	req.InitApiInfo(fmt.Sprintf("/api/v1/tenant/%s/users", tenantName), c.GetHost(), c.GetPort(), "GET")
+	req.SetAuthentication()
+	return req
+}
+
+type IterableTenantUsers struct {
+	Contents []model.TenantUser `json:"contents"`
+}
+
+type getTenantUsersResponse struct {
+	*response.OcsAgentResponse
+	*IterableTenantUsers
+}
+
+func (c *Client) createGetTenantUsersResponse() *getTenantUsersResponse {
+	resp := &getTenantUsersResponse{
+		OcsAgentResponse:    response.NewOcsAgentResponse(),
+		IterableTenantUsers: &IterableTenantUsers{},
+	}
+	resp.Data = resp.IterableTenantUsers
+	return resp
+}
+
+// GetTenantUsers returns a []TenantUser and an error.
+// If the error is non-nil, the []TenantUser will be empty.
+// tenantName: the name of the tenant.
+func (c *Client) GetTenantUsers(tenantName string) (users []model.TenantUser, err error) {
+	req := c.NewGetTenantUsersRequest(tenantName)
+	return c.GetTenantUsersWithRequest(req)
+}
+
+// GetTenantUsersWithRequest returns a []TenantUser and an error.
+// If the error is non-nil, the []TenantUser will be empty.
+func (c *Client) GetTenantUsersWithRequest(req *GetTenantUsersRequest) (users []model.TenantUser, err error) {
+	response := c.createGetTenantUsersResponse()
+	if err = c.Execute(req, response); err != nil {
+		return nil, err
+	}
+	return response.Contents, err
+}
@@ -0,0 +1,69 @@
+/*
+ * Copyright (c) 2024 OceanBase.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1
+
+import (
+	"fmt"
+
+	"github.com/oceanbase/obshell-sdk-go/model"
+	"github.com/oceanbase/obshell-sdk-go/sdk/request"
+	"github.com/oceanbase/obshell-sdk-go/sdk/response"
+)
+
+type GetZoneRequest struct {
+	*request.BaseRequest
+}
+
+type getZoneResponse struct {
+	*response.OcsAgentResponse
+	*model.ZoneInfo
+}
+
+func (c *Client) createGetZoneResponse() *getZoneResponse {
+	resp := &getZoneResponse{
+		OcsAgentResponse: response.NewOcsAgentResponse(),
+		ZoneInfo:         &model.ZoneInfo{},
+	}
+	resp.Data = resp.ZoneInfo
+	return resp
+}
+
+// NewGetZoneRequest returns a GetZoneRequest, which can be used as the argument for the GetZoneWithRequest.
+// zoneName: the name of zone.
+func (c *Client) NewGetZoneRequest(zoneName string) *GetZoneRequest {
+	req := &GetZoneRequest{
+		BaseRequest: request.NewBaseRequest(),
+	}
+	req.SetAuthentication()
+	req.InitApiInfo(fmt.Sprintf("/api/v1/zone/%s", zoneName), c.GetHost(), c.GetPort(), "GET")
+	return req
+}
+
+// GetZone returns the status of the zone, with its servers and the units placed on it.
+func (c *Client) GetZone(zoneName string) (*model.ZoneInfo, error) {
+	request := c.NewGetZoneRequest(zoneName)
+	return c.GetZoneWithRequest(request)
+}
+
+// GetZoneWithRequest returns the status of the zone with a GetZoneRequest.
+func (c *Client) GetZoneWithRequest(request *GetZoneRequest) (*model.ZoneInfo, error) {
+	response := c.createGetZoneResponse()
+	if err := c.Execute(request, response); err != nil {
+		return nil, err
+	}
+	return response.ZoneInfo, nil
+}
@@ -0,0 +1,70 @@
+/*
+ * Copyright (c) 2024 OceanBase.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1
+
+import (
+	"fmt"
+
+	"github.com/oceanbase/obshell-sdk-go/model"
+	"github.com/oceanbase/obshell-sdk-go/sdk/request"
+	"github.com/oceanbase/obshell-sdk-go/sdk/response"
+)
+
+type GrantTenantUserDbPrivilegesRequest struct {
+	*request.BaseRequest
+}
+
+type grantTenantUserDbPrivilegesResponse struct {
+	*response.OcsAgentResponse
+}
+
+func (c *Client) createGrantTenantUserDbPrivilegesResponse() *grantTenantUserDbPrivilegesResponse {
+	return &grantTenantUserDbPrivilegesResponse{
+		OcsAgentResponse: response.NewOcsAgentResponseWithoutReturn(),
+	}
+}
+
+// NewGrantTenantUserDbPrivilegesRequest return a GrantTenantUserDbPrivilegesRequest, which can be used as the argument for the GrantTenantUserDbPrivilegesWithRequest.
+// tenantName: the name of the tenant.
+// userName: the name of the user.
+// privileges: the database-level privileges to be granted.
+func (c *Client) NewGrantTenantUserDbPrivilegesRequest(tenantName string, userName string, privileges []model.DbPrivilege) *GrantTenantUserDbPrivilegesRequest {
+	req := &GrantTenantUserDbPrivilegesRequest{
+		BaseRequest: request.NewBaseRequest(),
+	}
+	req.SetBody(map[string]interface{}{
+		"db_privileges": privileges,
+	})
+	req.SetAuthentication()
+	req.InitApiInfo(fmt.Sprintf("/api/v1/tenant/%s/user/%s/db-privileges", tenantName, userName), c.GetHost(), c.GetPort(), "POST")
+	return req
+}
+
+// GrantTenantUserDbPrivileges grants the database-level privileges of a user of the tenant.
+// tenantName: the name of the tenant.
+// userName: the name of the user.
+// privileges: the database-level privileges to be granted.
+func (c *Client) GrantTenantUserDbPrivileges(tenantName string, userName string, privileges []model.DbPrivilege) error {
+	request := c.NewGrantTenantUserDbPrivilegesRequest(tenantName, userName, privileges)
+	return c.GrantTenantUserDbPrivilegesWithRequest(request)
+}
+
+// GrantTenantUserDbPrivilegesWithRequest grants the database-level privileges of a user of the tenant with a GrantTenantUserDbPrivilegesRequest.
+func (c *Client) GrantTenantUserDbPrivilegesWithRequest(request *GrantTenantUserDbPrivilegesRequest) error {
+	response := c.createGrantTenantUserDbPrivilegesResponse()
+	return c.Execute(request, response)
+}
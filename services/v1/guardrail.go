@@ -0,0 +1,318 @@
+/*
+ * Copyright (c) 2024 OceanBase.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/oceanbase/obshell-sdk-go/internal/util"
+	"github.com/oceanbase/obshell-sdk-go/sdk/option"
+	"github.com/oceanbase/obshell-sdk-go/sdk/request"
+)
+
+const (
+	GUARDRAIL_PROTECTED             = "PROTECTED"
+	GUARDRAIL_CONFIRMATION_REQUIRED = "CONFIRMATION_REQUIRED"
+	GUARDRAIL_DRY_RUN               = "DRY_RUN"
+)
+
+const (
+	OPERATION_DROP_TENANT             = "DropTenant"
+	OPERATION_PURGE_RECYCLEBIN_TENANT = "PurgeRecyclebinTenant"
+	OPERATION_DROP_RESOURCE_POOL      = "DropResourcePool"
+	OPERATION_SCALE_IN                = "ScaleIn"
+	OPERATION_STOP                    = "Stop"
+	OPERATION_REMOVE                  = "Remove"
+	OPERATION_DROP_TENANT_DATABASE    = "DropTenantDatabase"
+	OPERATION_DROP_TENANT_USER        = "DropTenantUser"
+	OPERATION_DELETE_ZONE             = "DeleteZone"
+	OPERATION_MERGE_RESOURCE_POOLS    = "MergeResourcePools"
+	OPERATION_SPLIT_RESOURCE_POOL     = "SplitResourcePool"
+)
+
+const recyclebinObjectPrefix = "__recycle_$_"
+
+// GuardrailPolicy is the policy for the destructive operations:
+// DropTenant, PurgeRecyclebinTenant, DropTenantDatabase, DropTenantUser, DropResourcePool, MergeResourcePools,
+// SplitResourcePool, ScaleIn, Stop, Remove and DeleteZone.
+// All the patterns use the syntax of path.Match, such as "prod_*".
+type GuardrailPolicy struct {
+	ProtectedTenants       []string // The tenants which can not be dropped or purged, and whose databases and users can not be dropped.
+	ProtectedResourcePools []string // The resource pools which can not be dropped, merged or split.
+	ProtectedZones         []string // The zones which can not be stopped or deleted, and whose servers can not be stopped, scaled in or removed.
+	ProtectedServers       []string // The servers('ip:port' of the agent) which can not be stopped, scaled in or removed.
+	ProtectCluster         bool     // Whether to forbid stopping the whole cluster.
+	ConfirmToken           string   // If not empty, the destructive operations must be confirmed by WithConfirmToken.
+	DryRun                 bool     // If true, the destructive operations will not be executed and a GuardrailError with kind GUARDRAIL_DRY_RUN will be returned.
+}
+
+type GuardrailOption struct {
+	*option.BaseOption
+}
+
+// WithGuardrail returns an option to enable the guardrail of the destructive operations.
+//
+// AS: v1.NewClient("127.0.0.1", 2886, sdk.WithPasswordAuth("password"), v1.WithGuardrail(v1.GuardrailPolicy{ProtectedTenants: []string{"prod_*"}}))
+func WithGuardrail(policy GuardrailPolicy) *GuardrailOption {
+	return &GuardrailOption{
+		BaseOption: option.NewBaseOption("guardrail", option.GUARDRAIL_OPT, &policy),
+	}
+}
+
+// GuardrailError is returned when a destructive operation is intercepted by the guardrail,
+// no request has been sent to the agent when it is returned.
+type GuardrailError struct {
+	Kind      string   // GUARDRAIL_PROTECTED, GUARDRAIL_CONFIRMATION_REQUIRED or GUARDRAIL_DRY_RUN.
+	Operation string   // The name of the operation, such as OPERATION_DROP_TENANT.
+	Targets   []string // The targets of the operation.
+	Method    string   // The http method of the request which would be sent.
+	Uri       string   // The uri of the request which would be sent.
+	Body      interface{}
+	Reason    string
+}
+
+func (e *GuardrailError) Error() string {
+	switch e.Kind {
+	case GUARDRAIL_DRY_RUN:
+		return fmt.Sprintf("dry run: %s %s would be executed by '%s %s'", e.Operation, strings.Join(e.Targets, ","), e.Method, e.Uri)
+	default:
+		return fmt.Sprintf("%s %s is rejected by guardrail: %s", e.Operation, strings.Join(e.Targets, ","), e.Reason)
+	}
+}
+
+// IsDryRun returns true if err is a GuardrailError returned in dry-run mode.
+func IsDryRun(err error) bool {
+	if e, ok := err.(*GuardrailError); ok {
+		return e.Kind == GUARDRAIL_DRY_RUN
+	}
+	return false
+}
+
+// GetGuardrailPolicy returns the guardrail policy of the client, nil means the guardrail is disabled.
+func (c *Client) GetGuardrailPolicy() *GuardrailPolicy {
+	return c.guardrail
+}
+
+// SetGuardrailPolicy sets the guardrail policy of the client, nil means disable the guardrail.
+func (c *Client) SetGuardrailPolicy(policy *GuardrailPolicy) {
+	c.guardrail = policy
+}
+
+// WithConfirmToken returns a copy of the client which carries the confirmation token.
+// The copy shares the connection and authentication with the original client.
+//
+// AS: client.WithConfirmToken("I_KNOW_WHAT_I_AM_DOING").DropTenant("t1")
+func (c *Client) WithConfirmToken(token string) *Client {
+	copied := *c
+	copied.confirmToken = token
+	return &copied
+}
+
+func (c *Client) setGuardrailByOptions(options ...option.Optioner) {
+	for _, opt := range options {
+		if opt.Type() == option.GUARDRAIL_OPT {
+			c.guardrail = opt.Value().(*GuardrailPolicy)
+		}
+	}
+}
+
+// checkGuardrail checks the destructive operation with the guardrail policy.
+// protectedPatterns: the patterns which the targets must not match.
+func (c *Client) checkGuardrail(req request.Request, operation string, targets []string, protectedPatterns []string) error {
+	if c.guardrail == nil {
+		return nil
+	}
+	newError := func(kind string, reason string) error {
+		return newGuardrailError(req, kind, operation, targets, reason)
+	}
+
+	for _, target := range targets {
+		if pattern := matchPatterns(protectedPatterns, target); pattern != "" {
+			return newError(GUARDRAIL_PROTECTED, fmt.Sprintf("'%s' matches the protected pattern '%s'", target, pattern))
+		}
+	}
+	if c.guardrail.ConfirmToken != "" && c.guardrail.ConfirmToken != c.confirmToken {
+		return newError(GUARDRAIL_CONFIRMATION_REQUIRED, "confirmation token is missing or mismatched")
+	}
+	if c.guardrail.DryRun {
+		return newError(GUARDRAIL_DRY_RUN, "")
+	}
+	return nil
+}
+
+func newGuardrailError(req request.Request, kind string, operation string, targets []string, reason string) error {
+	uri, _ := req.GetUri()
+	return &GuardrailError{
+		Kind:      kind,
+		Operation: operation,
+		Targets:   targets,
+		Method:    req.GetMethod(),
+		Uri:       uri,
+		Body:      req.GetBody(),
+		Reason:    reason,
+	}
+}
+
+// checkServersGuardrail checks the operation on the servers('ip:port' of the agents),
+// a server is protected if it matches ProtectedServers or it is in a zone which matches ProtectedZones.
+func (c *Client) checkServersGuardrail(req request.Request, operation string, servers []string) error {
+	if c.guardrail == nil {
+		return nil
+	}
+	if len(c.guardrail.ProtectedZones) != 0 {
+		for _, server := range servers {
+			if matchPatterns(c.guardrail.ProtectedServers, server) != "" {
+				// Rejected by checkGuardrail without querying the zones.
+				return c.checkGuardrail(req, operation, servers, c.guardrail.ProtectedServers)
+			}
+		}
+		obInfo, err := c.GetObInfo()
+		if err != nil {
+			return err
+		}
+		for zone, zoneServers := range obInfo.Config.ZoneConfig {
+			pattern := matchPatterns(c.guardrail.ProtectedZones, zone)
+			if pattern == "" {
+				continue
+			}
+			for _, server := range zoneServers {
+				if util.ContainsString(servers, server.AgentAddr()) {
+					return newGuardrailError(req, GUARDRAIL_PROTECTED, operation, servers,
+						fmt.Sprintf("'%s' is in the zone '%s' which matches the protected pattern '%s'", server.AgentAddr(), zone, pattern))
+				}
+			}
+		}
+	}
+	return c.checkGuardrail(req, operation, servers, c.guardrail.ProtectedServers)
+}
+
+func (c *Client) checkDropTenantGuardrail(req *DropTenantRequest) error {
+	if c.guardrail == nil {
+		return nil
+	}
+	return c.checkGuardrail(req, OPERATION_DROP_TENANT, []string{req.tenantName}, c.guardrail.ProtectedTenants)
+}
+
+func (c *Client) checkPurgeRecyclebinTenantGuardrail(req *PurgeRecyclebinTenantRequest) error {
+	if c.guardrail == nil {
+		return nil
+	}
+	targets := []string{req.objectOrOriginalName}
+	// The object name in recyclebin never matches the protected tenants,
+	// so the original name need to be queried if the name itself is not protected.
+	if strings.HasPrefix(req.objectOrOriginalName, recyclebinObjectPrefix) && len(c.guardrail.ProtectedTenants) != 0 &&
+		matchPatterns(c.guardrail.ProtectedTenants, req.objectOrOriginalName) == "" {
+		tenants, err := c.GetAllRecyclebinTenants()
+		if err != nil {
+			return err
+		}
+		for _, tenant := range tenants {
+			if tenant.Name == req.objectOrOriginalName {
+				targets = append(targets, tenant.OriginalName)
+			}
+		}
+	}
+	return c.checkGuardrail(req, OPERATION_PURGE_RECYCLEBIN_TENANT, targets, c.guardrail.ProtectedTenants)
+}
+
+func (c *Client) checkDropResourcePoolGuardrail(req *DropResourcePoolRequest) error {
+	if c.guardrail == nil {
+		return nil
+	}
+	return c.checkGuardrail(req, OPERATION_DROP_RESOURCE_POOL, []string{req.poolName}, c.guardrail.ProtectedResourcePools)
+}
+
+func (c *Client) checkScaleInGuardrail(req *ScaleInRequest) error {
+	if c.guardrail == nil {
+		return nil
+	}
+	server := fmt.Sprintf("%s:%d", req.param.AgentInfo.Ip, req.param.AgentInfo.Port)
+	return c.checkServersGuardrail(req, OPERATION_SCALE_IN, []string{server})
+}
+
+func (c *Client) checkRemoveGuardrail(req *RemoveRequest) error {
+	if c.guardrail == nil {
+		return nil
+	}
+	server := fmt.Sprintf("%s:%d", req.agent.Ip, req.agent.Port)
+	return c.checkServersGuardrail(req, OPERATION_REMOVE, []string{server})
+}
+
+func (c *Client) checkStopGuardrail(req *StopRequest) error {
+	if c.guardrail == nil {
+		return nil
+	}
+	scope := req.param.Scope
+	switch scope.Type {
+	case SCOPE_GLOBAL:
+		if c.guardrail.ProtectCluster {
+			return c.checkGuardrail(req, OPERATION_STOP, []string{SCOPE_GLOBAL}, []string{SCOPE_GLOBAL})
+		}
+		return c.checkGuardrail(req, OPERATION_STOP, []string{SCOPE_GLOBAL}, nil)
+	case SCOPE_ZONE:
+		return c.checkGuardrail(req, OPERATION_STOP, scope.Target, c.guardrail.ProtectedZones)
+	default:
+		return c.checkServersGuardrail(req, OPERATION_STOP, scope.Target)
+	}
+}
+
+func (c *Client) checkDropTenantDatabaseGuardrail(req *DropTenantDatabaseRequest) error {
+	if c.guardrail == nil {
+		return nil
+	}
+	return c.checkGuardrail(req, OPERATION_DROP_TENANT_DATABASE, []string{req.tenantName}, c.guardrail.ProtectedTenants)
+}
+
+func (c *Client) checkDropTenantUserGuardrail(req *DropTenantUserRequest) error {
+	if c.guardrail == nil {
+		return nil
+	}
+	return c.checkGuardrail(req, OPERATION_DROP_TENANT_USER, []string{req.tenantName}, c.guardrail.ProtectedTenants)
+}
+
+func (c *Client) checkDeleteZoneGuardrail(req *DeleteZoneRequest) error {
+	if c.guardrail == nil {
+		return nil
+	}
+	return c.checkGuardrail(req, OPERATION_DELETE_ZONE, []string{req.zoneName}, c.guardrail.ProtectedZones)
+}
+
+func (c *Client) checkMergeResourcePoolsGuardrail(req *MergeResourcePoolsRequest) error {
+	if c.guardrail == nil {
+		return nil
+	}
+	return c.checkGuardrail(req, OPERATION_MERGE_RESOURCE_POOLS, req.param.PoolList, c.guardrail.ProtectedResourcePools)
+}
+
+func (c *Client) checkSplitResourcePoolGuardrail(req *SplitResourcePoolRequest) error {
+	if c.guardrail == nil {
+		return nil
+	}
+	return c.checkGuardrail(req, OPERATION_SPLIT_RESOURCE_POOL, []string{req.poolName}, c.guardrail.ProtectedResourcePools)
+}
+
+// matchPatterns returns the first pattern matched by name, or empty string if nothing matched.
+func matchPatterns(patterns []string, name string) string {
+	for _, pattern := range patterns {
+		if matched, _ := path.Match(pattern, name); matched {
+			return pattern
+		}
+	}
+	return ""
+}
@@ -0,0 +1,489 @@
+/*
+ * Copyright (c) 2024 OceanBase.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/oceanbase/obshell-sdk-go/internal/util"
+	"github.com/oceanbase/obshell-sdk-go/model"
+)
+
+const (
+	HEALTH_OK       = "OK"
+	HEALTH_INFO     = "INFO"
+	HEALTH_WARNING  = "WARNING"
+	HEALTH_CRITICAL = "CRITICAL"
+)
+
+var healthSeverityLevel = map[string]int{
+	HEALTH_OK:       0,
+	HEALTH_INFO:     1,
+	HEALTH_WARNING:  2,
+	HEALTH_CRITICAL: 3,
+}
+
+// The names of the built-in health checks.
+const (
+	HEALTH_CHECK_AGENTS          = "agents"
+	HEALTH_CHECK_MAINTENANCE     = "maintenance"
+	HEALTH_CHECK_FAILED_DAGS     = "failed dags"
+	HEALTH_CHECK_SERVERS         = "servers"
+	HEALTH_CHECK_OB_STATE        = "ob state"
+	HEALTH_CHECK_TENANT_LOCK     = "tenant lock"
+	HEALTH_CHECK_ARCHIVE_LOG_LAG = "archive log lag"
+)
+
+const (
+	defaultMaxMaintenanceDuration = 30 * time.Minute
+	defaultMaxArchiveLogLag       = 10 * time.Minute
+)
+
+// HealthCheckResult is a finding of a health check.
+type HealthCheckResult struct {
+	Check       string `json:"check"`
+	Severity    string `json:"severity"` // HEALTH_OK, HEALTH_INFO, HEALTH_WARNING or HEALTH_CRITICAL.
+	Target      string `json:"target"`   // Such as the agent, the server or the tenant.
+	Message     string `json:"message"`
+	Remediation string `json:"remediation,omitempty"`
+}
+
+// HealthCheckFunc checks the cluster and returns the findings.
+// The returned results do not need to set Check, it will be filled with the name of the check.
+type HealthCheckFunc func(env *HealthCheckEnv) []HealthCheckResult
+
+// HealthCheckOptions is the options of the built-in health checks.
+type HealthCheckOptions struct {
+	ExpectedIdentity       model.AgentIdentity // Default is CLUSTER AGENT.
+	MaxMaintenanceDuration time.Duration       // Default is 30 minutes.
+	MaxArchiveLogLag       time.Duration       // Default is 10 minutes.
+	LockedTenants          []string            // The tenants which are expected to be locked.
+}
+
+// HealthCheckEnv is passed to the health checks, it caches the information shared by the checks.
+type HealthCheckEnv struct {
+	Client  *Client
+	Options HealthCheckOptions
+
+	obInfo   *model.ObInfoResp
+	statuses map[string]*model.AgentStatus
+	errors   map[string]error
+}
+
+// ObInfo returns the ObInfoResp of the cluster, it is queried only once.
+func (env *HealthCheckEnv) ObInfo() (*model.ObInfoResp, error) {
+	if env.obInfo == nil {
+		obInfo, err := env.Client.GetObInfo()
+		if err != nil {
+			return nil, err
+		}
+		env.obInfo = obInfo
+	}
+	return env.obInfo, nil
+}
+
+// AgentStatuses returns the status of all the agents, keyed by 'ip:port'.
+// The agents are queried concurrently, the ones which can not be reached are returned in the errors.
+func (env *HealthCheckEnv) AgentStatuses() (map[string]*model.AgentStatus, map[string]error, error) {
+	if env.statuses != nil {
+		return env.statuses, env.errors, nil
+	}
+	obInfo, err := env.ObInfo()
+	if err != nil {
+		return nil, nil, err
+	}
+	env.statuses = make(map[string]*model.AgentStatus)
+	env.errors = make(map[string]error)
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for _, agent := range obInfo.Agents {
+		// The clients of the agents are created before the goroutines, as the client is not thread-safe.
+		client, err := env.Client.newAgentClient(agent.Ip, agent.Port)
+		if err != nil {
+			env.errors[agent.String()] = err
+			continue
+		}
+		wg.Add(1)
+		go func(agent model.AgentInstance, client *Client) {
+			defer wg.Done()
+			status, err := client.GetStatus()
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				env.errors[agent.String()] = err
+			} else {
+				env.statuses[agent.String()] = status
+			}
+		}(agent, client)
+	}
+	wg.Wait()
+	return env.statuses, env.errors, nil
+}
+
+type namedHealthCheck struct {
+	name  string
+	check HealthCheckFunc
+}
+
+// HealthChecker runs the registered health checks.
+type HealthChecker struct {
+	client  *Client
+	options HealthCheckOptions
+	checks  []namedHealthCheck
+}
+
+// NewHealthChecker returns a HealthChecker with all the built-in checks registered.
+func (c *Client) NewHealthChecker(options HealthCheckOptions) *HealthChecker {
+	if options.ExpectedIdentity == "" {
+		options.ExpectedIdentity = model.CLUSTER_AGENT
+	}
+	if options.MaxMaintenanceDuration <= 0 {
+		options.MaxMaintenanceDuration = defaultMaxMaintenanceDuration
+	}
+	if options.MaxArchiveLogLag <= 0 {
+		options.MaxArchiveLogLag = defaultMaxArchiveLogLag
+	}
+	h := &HealthChecker{
+		client:  c,
+		options: options,
+	}
+	h.Register(HEALTH_CHECK_AGENTS, checkAgentsHealth)
+	h.Register(HEALTH_CHECK_MAINTENANCE, checkMaintenanceHealth)
+	h.Register(HEALTH_CHECK_FAILED_DAGS, checkFailedDagsHealth)
+	h.Register(HEALTH_CHECK_SERVERS, checkServersHealth)
+	h.Register(HEALTH_CHECK_OB_STATE, checkObStateHealth)
+	h.Register(HEALTH_CHECK_TENANT_LOCK, checkTenantLockHealth)
+	h.Register(HEALTH_CHECK_ARCHIVE_LOG_LAG, checkArchiveLogLagHealth)
+	return h
+}
+
+// CheckHealth runs all the built-in health checks with the default options.
+func (c *Client) CheckHealth() *HealthReport {
+	return c.NewHealthChecker(HealthCheckOptions{}).Run()
+}
+
+// Register registers a health check, the check with the same name will be replaced.
+func (h *HealthChecker) Register(name string, check HealthCheckFunc) *HealthChecker {
+	for i := range h.checks {
+		if h.checks[i].name == name {
+			h.checks[i].check = check
+			return h
+		}
+	}
+	h.checks = append(h.checks, namedHealthCheck{name: name, check: check})
+	return h
+}
+
+// Unregister removes the health check with the name.
+func (h *HealthChecker) Unregister(name string) *HealthChecker {
+	for i := range h.checks {
+		if h.checks[i].name == name {
+			h.checks = append(h.checks[:i], h.checks[i+1:]...)
+			break
+		}
+	}
+	return h
+}
+
+// Checks returns the names of the registered checks in execution order.
+func (h *HealthChecker) Checks() []string {
+	names := make([]string, 0, len(h.checks))
+	for _, check := range h.checks {
+		names = append(names, check.name)
+	}
+	return names
+}
+
+// Run runs all the registered checks in order and returns the report.
+func (h *HealthChecker) Run() *HealthReport {
+	env := &HealthCheckEnv{
+		Client:  h.client,
+		Options: h.options,
+	}
+	report := &HealthReport{
+		Timestamp: time.Now(),
+		Results:   make([]HealthCheckResult, 0),
+	}
+	for _, check := range h.checks {
+		results := check.check(env)
+		if len(results) == 0 {
+			results = []HealthCheckResult{{Severity: HEALTH_OK}}
+		}
+		for _, result := range results {
+			result.Check = check.name
+			report.Results = append(report.Results, result)
+		}
+	}
+	return report
+}
+
+// HealthReport is the report of the health checks.
+type HealthReport struct {
+	Timestamp time.Time           `json:"timestamp"`
+	Results   []HealthCheckResult `json:"results"`
+}
+
+// Severity returns the most severe severity in the report.
+func (r *HealthReport) Severity() string {
+	severity := HEALTH_OK
+	for _, result := range r.Results {
+		if healthSeverityLevel[result.Severity] > healthSeverityLevel[severity] {
+			severity = result.Severity
+		}
+	}
+	return severity
+}
+
+// IsHealthy returns true if there is no WARNING or CRITICAL finding.
+func (r *HealthReport) IsHealthy() bool {
+	return healthSeverityLevel[r.Severity()] < healthSeverityLevel[HEALTH_WARNING]
+}
+
+// Filter returns the results whose severity is not less than the given severity.
+func (r *HealthReport) Filter(severity string) []HealthCheckResult {
+	results := make([]HealthCheckResult, 0)
+	for _, result := range r.Results {
+		if healthSeverityLevel[result.Severity] >= healthSeverityLevel[severity] {
+			results = append(results, result)
+		}
+	}
+	return results
+}
+
+// String renders the findings which are not OK, one per line.
+func (r *HealthReport) String() string {
+	lines := make([]string, 0)
+	for _, result := range r.Filter(HEALTH_INFO) {
+		line := fmt.Sprintf("[%s] %s %s: %s", result.Severity, result.Check, result.Target, result.Message)
+		if result.Remediation != "" {
+			line = fmt.Sprintf("%s (%s)", line, result.Remediation)
+		}
+		lines = append(lines, line)
+	}
+	if len(lines) == 0 {
+		return HEALTH_OK
+	}
+	return strings.Join(lines, "\n")
+}
+
+func healthCheckError(err error) []HealthCheckResult {
+	return []HealthCheckResult{{
+		Severity:    HEALTH_CRITICAL,
+		Target:      "cluster",
+		Message:     err.Error(),
+		Remediation: "check the network and the agent which the client connects to",
+	}}
+}
+
+func checkAgentsHealth(env *HealthCheckEnv) (results []HealthCheckResult) {
+	statuses, errs, err := env.AgentStatuses()
+	if err != nil {
+		return healthCheckError(err)
+	}
+	for agent, err := range errs {
+		results = append(results, HealthCheckResult{
+			Severity:    HEALTH_CRITICAL,
+			Target:      agent,
+			Message:     fmt.Sprintf("agent is unreachable: %v", err),
+			Remediation: "check whether obshell is running on the server, and start it by 'obshell admin start'",
+		})
+	}
+	for agent, status := range statuses {
+		if status.Agent.Identity != env.Options.ExpectedIdentity {
+			results = append(results, HealthCheckResult{
+				Severity:    HEALTH_CRITICAL,
+				Target:      agent,
+				Message:     fmt.Sprintf("identity is %s, expected %s", status.Agent.Identity, env.Options.ExpectedIdentity),
+				Remediation: "check the last maintenance task of the agent",
+			})
+		}
+	}
+	return
+}
+
+func checkMaintenanceHealth(env *HealthCheckEnv) (results []HealthCheckResult) {
+	statuses, _, err := env.AgentStatuses()
+	if err != nil {
+		return healthCheckError(err)
+	}
+	underMaintenance := false
+	for agent, status := range statuses {
+		if status.UnderMaintenance {
+			underMaintenance = true
+			results = append(results, HealthCheckResult{
+				Severity: HEALTH_INFO,
+				Target:   agent,
+				Message:  "agent is under maintenance",
+			})
+		}
+	}
+	if !underMaintenance {
+		return
+	}
+
+	dags, err := env.Client.GetAllAgentLastMaintenanceDag()
+	if err != nil {
+		return append(results, healthCheckError(err)...)
+	}
+	if dag, err := env.Client.GetObLastMaintenanceDag(); err == nil && dag != nil && dag.GenericDTO != nil {
+		dags = append(dags, dag)
+	}
+	for _, dag := range dags {
+		if dag == nil || dag.DagDetail == nil || !dag.IsRunning() {
+			continue
+		}
+		if duration := time.Since(dag.StartTime); duration > env.Options.MaxMaintenanceDuration {
+			results = append(results, HealthCheckResult{
+				Severity:    HEALTH_WARNING,
+				Target:      dag.GenericID,
+				Message:     fmt.Sprintf("task '%s' has been running for %s", dag.Name, duration.Truncate(time.Second)),
+				Remediation: "check the logs of the task by GetDag, and cancel or retry it by OperateDag if it is stuck",
+			})
+		}
+	}
+	return
+}
+
+func checkFailedDagsHealth(env *HealthCheckEnv) (results []HealthCheckResult) {
+	dags, err := env.Client.GetAllAgentLastMaintenanceDag()
+	if err != nil {
+		return healthCheckError(err)
+	}
+	if dag, err := env.Client.GetObLastMaintenanceDag(); err == nil && dag != nil && dag.GenericDTO != nil {
+		dags = append(dags, dag)
+	}
+	for _, dag := range dags {
+		if dag == nil || dag.DagDetail == nil || !dag.IsFailed() {
+			continue
+		}
+		results = append(results, HealthCheckResult{
+			Severity:    HEALTH_CRITICAL,
+			Target:      dag.GenericID,
+			Message:     fmt.Sprintf("task '%s' is failed: %s", dag.Name, strings.Join(model.GetFailedDagLastLog(dag), "; ")),
+			Remediation: fmt.Sprintf("retry the task by OperateDag(\"%s\", \"%s\") or roll it back by OperateDag(\"%s\", \"%s\")", dag.GenericID, model.RETRY_STR, dag.GenericID, model.ROLLBACK_STR),
+		})
+	}
+	return
+}
+
+func checkServersHealth(env *HealthCheckEnv) (results []HealthCheckResult) {
+	obInfo, err := env.ObInfo()
+	if err != nil {
+		return healthCheckError(err)
+	}
+	for zone, servers := range obInfo.Config.ZoneConfig {
+		for _, server := range servers {
+			if !server.IsActive() {
+				results = append(results, HealthCheckResult{
+					Severity:    HEALTH_CRITICAL,
+					Target:      fmt.Sprintf("%s:%d", server.SvrIP, server.SvrPort),
+					Message:     fmt.Sprintf("server in zone '%s' is %s", zone, server.Status),
+					Remediation: fmt.Sprintf("start the server by Start(SCOPE_SERVER, \"%s\")", server.AgentAddr()),
+				})
+			}
+		}
+	}
+	return
+}
+
+func checkObStateHealth(env *HealthCheckEnv) (results []HealthCheckResult) {
+	statuses, _, err := env.AgentStatuses()
+	if err != nil {
+		return healthCheckError(err)
+	}
+	for agent, status := range statuses {
+		switch status.OBState {
+		case model.OB_STATE_CONNECTION_AVAILABLE:
+		case model.OB_STATE_PROCESS_NOT_RUNNING:
+			results = append(results, HealthCheckResult{
+				Severity:    HEALTH_CRITICAL,
+				Target:      agent,
+				Message:     "observer is not running",
+				Remediation: fmt.Sprintf("start the observer by Start(SCOPE_SERVER, \"%s\")", agent),
+			})
+		default:
+			results = append(results, HealthCheckResult{
+				Severity:    HEALTH_WARNING,
+				Target:      agent,
+				Message:     fmt.Sprintf("observer is running but not available(state %d)", status.OBState),
+				Remediation: "check the observer log, the observer may be starting",
+			})
+		}
+	}
+	return
+}
+
+func checkTenantLockHealth(env *HealthCheckEnv) (results []HealthCheckResult) {
+	tenants, err := env.Client.GetAllTenantOverview()
+	if err != nil {
+		return healthCheckError(err)
+	}
+	for _, tenant := range tenants {
+		if tenant.Locked == "YES" && !util.ContainsString(env.Options.LockedTenants, tenant.Name) {
+			results = append(results, HealthCheckResult{
+				Severity:    HEALTH_WARNING,
+				Target:      tenant.Name,
+				Message:     "tenant is locked unexpectedly",
+				Remediation: fmt.Sprintf("unlock the tenant by UnlockTenant(\"%s\") if it is not expected", tenant.Name),
+			})
+		}
+	}
+	return
+}
+
+func checkArchiveLogLagHealth(env *HealthCheckEnv) (results []HealthCheckResult) {
+	tenants, err := env.Client.GetAllTenantOverview()
+	if err != nil {
+		return healthCheckError(err)
+	}
+	for _, tenant := range tenants {
+		if tenant.Name == "sys" {
+			continue // The log archive is only supported by the user tenants.
+		}
+		status, err := env.Client.GetTenantArchiveLogStatus(tenant.Name)
+		if errors.Is(err, ErrArchiveLogNotConfigured) {
+			continue
+		} else if err != nil {
+			results = append(results, healthCheckError(errors.Wrapf(err, "get archive log status of tenant '%s'", tenant.Name))...)
+			continue
+		}
+		switch status.Status {
+		case model.ARCHIVE_LOG_STATUS_DOING:
+			if lag := status.Lag(); lag > env.Options.MaxArchiveLogLag {
+				results = append(results, HealthCheckResult{
+					Severity:    HEALTH_WARNING,
+					Target:      tenant.Name,
+					Message:     fmt.Sprintf("archive log lag is %s, more than %s", lag.Truncate(time.Second), env.Options.MaxArchiveLogLag),
+					Remediation: "check the archive destination and the 'archive_lag_target' of the tenant",
+				})
+			}
+		case model.ARCHIVE_LOG_STATUS_INTERRUPT:
+			results = append(results, HealthCheckResult{
+				Severity:    HEALTH_CRITICAL,
+				Target:      tenant.Name,
+				Message:     fmt.Sprintf("log archive is interrupted: %s", status.Comment),
+				Remediation: "check the archive destination, then restart the log archive",
+			})
+		}
+	}
+	return
+}
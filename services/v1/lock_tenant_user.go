@@ -0,0 +1,62 @@
+/*
+ * Copyright (c) 2024 OceanBase.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1
+
+import (
+	"fmt"
+
+	"github.com/oceanbase/obshell-sdk-go/sdk/request"
+	"github.com/oceanbase/obshell-sdk-go/sdk/response"
+)
+
+type LockTenantUserRequest struct {
+	*request.BaseRequest
+}
+
+type lockTenantUserResponse struct {
+	*response.OcsAgentResponse
+}
+
+func (c *Client) createLockTenantUserResponse() *lockTenantUserResponse {
+	return &lockTenantUserResponse{
+		OcsAgentResponse: response.NewOcsAgentResponseWithoutReturn(),
+	}
+}
+
+// NewLockTenantUserRequest return a LockTenantUserRequest, which can be used as the argument for the LockTenantUserWithRequest.
+// tenantName: the name of the tenant.
+// userName: the name of the user.
+func (c *Client) NewLockTenantUserRequest(tenantName string, userName string) *LockTenantUserRequest {
+	req := &LockTenantUserRequest{
+		BaseRequest: request.NewBaseRequest(),
+	}
+	req.SetAuthentication()
+	req.InitApiInfo(fmt.Sprintf("/api/v1/tenant/%s/user/%s/lock", tenantName, userName), c.GetHost(), c.GetPort(), "POST")
+	return req
+}
+
+// LockTenantUser locks a user of the tenant.
+func (c *Client) LockTenantUser(tenantName string, userName string) error {
+	request := c.NewLockTenantUserRequest(tenantName, userName)
+	return c.LockTenantUserWithRequest(request)
+}
+
+// LockTenantUserWithRequest locks a user of the tenant with a LockTenantUserRequest.
+func (c *Client) LockTenantUserWithRequest(request *LockTenantUserRequest) error {
+	response := c.createLockTenantUserResponse()
+	return c.Execute(request, response)
+}
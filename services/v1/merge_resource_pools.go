@@ -0,0 +1,99 @@
+/*
+ * Copyright (c) 2024 OceanBase.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1
+
+import (
+	"github.com/oceanbase/obshell-sdk-go/model"
+	"github.com/oceanbase/obshell-sdk-go/sdk/request"
+	"github.com/oceanbase/obshell-sdk-go/sdk/response"
+)
+
+type MergeResourcePoolsRequest struct {
+	*request.BaseRequest
+	param MergeResourcePoolsParam
+}
+
+type MergeResourcePoolsParam struct {
+	PoolList    []string `json:"pool_list" binding:"required"`     // The pools to be merged, they should use the same unit config and unit num.
+	NewPoolName string   `json:"new_pool_name" binding:"required"` // The name of the merged pool.
+}
+
+type MergeResourcePoolsResponse struct {
+	*response.TaskResponse
+}
+
+func (c *Client) createMergeResourcePoolsResponse() *MergeResourcePoolsResponse {
+	return &MergeResourcePoolsResponse{
+		TaskResponse: response.NewTaskResponse(),
+	}
+}
+
+// NewMergeResourcePoolsRequest return a MergeResourcePoolsRequest, which can be used as the argument for the MergeResourcePoolsWithRequest/MergeResourcePoolsSyncWithRequest.
+// poolList: the pools to be merged, they should use the same unit config and unit num, and belong to the same tenant or no tenant.
+// newPoolName: the name of the merged pool.
+// The pools in poolList will be dropped after merging.
+func (c *Client) NewMergeResourcePoolsRequest(poolList []string, newPoolName string) *MergeResourcePoolsRequest {
+	req := &MergeResourcePoolsRequest{
+		BaseRequest: request.NewAsyncBaseRequest(),
+		param: MergeResourcePoolsParam{
+			PoolList:    poolList,
+			NewPoolName: newPoolName,
+		},
+	}
+	req.SetBody(&req.param)
+	req.SetAuthentication()
+	req.InitApiInfo("/api/v1/resource-pools/merge", c.GetHost(), c.GetPort(), "POST")
+	return req
+}
+
+// MergeResourcePools returns a DagDetailDTO and an error, when the task is completed successfully, the error will be nil.
+// poolList: the pools to be merged.
+// newPoolName: the name of the merged pool.
+func (c *Client) MergeResourcePools(poolList []string, newPoolName string) (*model.DagDetailDTO, error) {
+	request := c.NewMergeResourcePoolsRequest(poolList, newPoolName)
+	return c.MergeResourcePoolsSyncWithRequest(request)
+}
+
+// MergeResourcePoolsWithRequest returns a DagDetailDTO and an error, when the task is requested successfully, the error will be nil.
+// the parameter is a MergeResourcePoolsRequest, which can be created by NewMergeResourcePoolsRequest.
+// You can use WaitDagSucceed to wait for the task to complete.
+// You can check or operater the task through the DagDetailDTO.
+func (c *Client) MergeResourcePoolsWithRequest(request *MergeResourcePoolsRequest) (dag *model.DagDetailDTO, err error) {
+	if err = c.checkMergeResourcePoolsGuardrail(request); err != nil {
+		return nil, err
+	}
+	response := c.createMergeResourcePoolsResponse()
+	if err = c.Execute(request, response); err != nil {
+		return nil, err
+	}
+	return response.DagDetailDTO, nil
+}
+
+// MergeResourcePoolsSyncWithRequest returns a DagDetailDTO and an error, when the task is completed successfully, the error will be nil.
+// the DagDetailDTO is the final status of the task.
+// the parameter is a MergeResourcePoolsRequest, which can be created by NewMergeResourcePoolsRequest.
+// You can check or operater the task through the DagDetailDTO.
+// If the agent merges the resource pools synchronously, the DagDetailDTO will be nil.
+func (c *Client) MergeResourcePoolsSyncWithRequest(request *MergeResourcePoolsRequest) (dag *model.DagDetailDTO, err error) {
+	if dag, err = c.MergeResourcePoolsWithRequest(request); err != nil {
+		return nil, err
+	}
+	if dag == nil || dag.GenericDTO == nil {
+		return nil, nil
+	}
+	return c.WaitDagSucceed(dag.GenericID)
+}
@@ -0,0 +1,117 @@
+/*
+ * Copyright (c) 2024 OceanBase.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1
+
+import (
+	"fmt"
+
+	"github.com/oceanbase/obshell-sdk-go/model"
+	"github.com/oceanbase/obshell-sdk-go/sdk/request"
+	"github.com/oceanbase/obshell-sdk-go/sdk/response"
+)
+
+type ModifyTenantDatabaseRequest struct {
+	*request.BaseRequest
+	param ModifyTenantDatabaseParam
+}
+
+type ModifyTenantDatabaseParam struct {
+	Collation *string `json:"collation"` // optional
+	ReadOnly  *bool   `json:"read_only"` // optional
+	Comment   *string `json:"comment"`   // optional
+}
+
+type ModifyTenantDatabaseResponse struct {
+	*response.TaskResponse
+}
+
+func (c *Client) createModifyTenantDatabaseResponse() *ModifyTenantDatabaseResponse {
+	return &ModifyTenantDatabaseResponse{
+		TaskResponse: response.NewTaskResponse(),
+	}
+}
+
+// NewModifyTenantDatabaseRequest return a ModifyTenantDatabaseRequest, which can be used as the argument for the ModifyTenantDatabaseWithRequest/ModifyTenantDatabaseSyncWithRequest.
+// tenantName: the name of the tenant.
+// dbName: the name of the database.
+// You can set the collation, read only and comment by calling SetCollation, SetReadOnly and SetComment.
+// Only the properties which have been set will be modified.
+func (c *Client) NewModifyTenantDatabaseRequest(tenantName string, dbName string) *ModifyTenantDatabaseRequest {
+	req := &ModifyTenantDatabaseRequest{
+		BaseRequest: request.NewAsyncBaseRequest(),
+	}
+	req.SetBody(&req.param)
+	req.SetAuthentication()
+	req.InitApiInfo(fmt.Sprintf("/api/v1/tenant/%s/database/%s", tenantName, dbName), c.GetHost(), c.GetPort(), "PATCH")
+	return req
+}
+
+func (r *ModifyTenantDatabaseRequest) SetCollation(collation string) *ModifyTenantDatabaseRequest {
+	r.param.Collation = &collation
+	r.SetBody(&r.param)
+	return r
+}
+
+func (r *ModifyTenantDatabaseRequest) SetReadOnly(readOnly bool) *ModifyTenantDatabaseRequest {
+	r.param.ReadOnly = &readOnly
+	r.SetBody(&r.param)
+	return r
+}
+
+func (r *ModifyTenantDatabaseRequest) SetComment(comment string) *ModifyTenantDatabaseRequest {
+	r.param.Comment = &comment
+	r.SetBody(&r.param)
+	return r
+}
+
+// ModifyTenantDatabase returns a DagDetailDTO and an error, when the task is completed successfully, the error will be nil.
+// tenantName: the name of the tenant.
+// dbName: the name of the database.
+// param: the properties to be modified, the nil fields will not be modified.
+func (c *Client) ModifyTenantDatabase(tenantName string, dbName string, param ModifyTenantDatabaseParam) (*model.DagDetailDTO, error) {
+	request := c.NewModifyTenantDatabaseRequest(tenantName, dbName)
+	request.param = param
+	request.SetBody(&request.param)
+	return c.ModifyTenantDatabaseSyncWithRequest(request)
+}
+
+// ModifyTenantDatabaseWithRequest returns a DagDetailDTO and an error, when the task is requested successfully, the error will be nil.
+// the parameter is a ModifyTenantDatabaseRequest, which can be created by NewModifyTenantDatabaseRequest.
+// You can use WaitDagSucceed to wait for the task to complete.
+// You can check or operater the task through the DagDetailDTO.
+func (c *Client) ModifyTenantDatabaseWithRequest(request *ModifyTenantDatabaseRequest) (dag *model.DagDetailDTO, err error) {
+	response := c.createModifyTenantDatabaseResponse()
+	if err = c.Execute(request, response); err != nil {
+		return nil, err
+	}
+	return response.DagDetailDTO, nil
+}
+
+// ModifyTenantDatabaseSyncWithRequest returns a DagDetailDTO and an error, when the task is completed successfully, the error will be nil.
+// the DagDetailDTO is the final status of the task.
+// the parameter is a ModifyTenantDatabaseRequest, which can be created by NewModifyTenantDatabaseRequest.
+// You can check or operater the task through the DagDetailDTO.
+// If the database is modified synchronously by the agent, the DagDetailDTO will be nil.
+func (c *Client) ModifyTenantDatabaseSyncWithRequest(request *ModifyTenantDatabaseRequest) (dag *model.DagDetailDTO, err error) {
+	if dag, err = c.ModifyTenantDatabaseWithRequest(request); err != nil {
+		return nil, err
+	}
+	if dag == nil || dag.GenericDTO == nil {
+		return nil, nil
+	}
+	return c.WaitDagSucceed(dag.GenericID)
+}
@@ -79,6 +79,9 @@ func (c *Client) ScaleIn(ip string, port int) (*model.DagDetailDTO, error) {
 // You can use WaitDagSucceed to wait for the task to complete.
 // You can check or operater the task through the DagDetailDTO.
 func (c *Client) ScaleInWithRequest(request *ScaleInRequest) (dag *model.DagDetailDTO, err error) {
+	if err = c.checkScaleInGuardrail(request); err != nil {
+		return nil, err
+	}
 	response := c.createScaleInResponse()
 	if err = c.Execute(request, response); err != nil {
 		return nil, err
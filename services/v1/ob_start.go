@@ -84,6 +84,12 @@ func (c *Client) Start(level string, targets ...string) (*model.DagDetailDTO, er
 	return c.StartSyncWithRequest(request)
 }
 
+// StartZone returns a DagDetailDTO and an error, when the start task is completed successfully, the error will be nil.
+// zones: the names of the zones to be started.
+func (c *Client) StartZone(zones ...string) (*model.DagDetailDTO, error) {
+	return c.Start(SCOPE_ZONE, zones...)
+}
+
 // StartWithRequest returns a DagDetailDTO and an error, when the start task is requested successfully, the error will be nil.
 // the parameter is a StartRequest, which can be created by NewStartRequest.
 // You can use WaitDagSucceed to wait for the task to complete.
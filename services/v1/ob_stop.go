@@ -100,11 +100,20 @@ func (c *Client) Stop(level string, targets ...string) (*model.DagDetailDTO, err
 	return c.StopSyncWithRequest(request)
 }
 
+// StopZone returns a DagDetailDTO and an error, when the stop task is completed successfully, the error will be nil.
+// zones: the names of the zones to be stopped.
+func (c *Client) StopZone(zones ...string) (*model.DagDetailDTO, error) {
+	return c.Stop(SCOPE_ZONE, zones...)
+}
+
 // StopWithRequest returns a DagDetailDTO and an error, when the stop task is requested successfully, the error will be nil.
 // the parameter is a StopRequest, which can be created by CreateStopRequest.
 // You can use WaitDagSucceed to wait for the task to complete.
 // You can check or operater the task through the DagDetailDTO.
 func (c *Client) StopWithRequest(request *StopRequest) (dag *model.DagDetailDTO, err error) {
+	if err = c.checkStopGuardrail(request); err != nil {
+		return nil, err
+	}
 	response := c.createStopResponse()
 	if err = c.Execute(request, response); err != nil {
 		return nil, err
@@ -89,11 +89,13 @@ func (c *Client) UpgradeOb(version, release, mode string) (*model.DagDetailDTO,
 // the parameter is a UpgradeObRequest, which can be created by NewUpgradeObRequest.
 // You need to call WaitDagSucceedWithRetry instead of WaitDagSucceed to query the task status.
 // You can check or operater the task through the DagDetailDTO.
+// The cached agent version is cleared, call ResetAgentVersion again after the task is completed if you wait for it by yourself.
 func (c *Client) UpgradeObWithRequest(req *UpgradeObRequest) (dag *model.DagDetailDTO, err error) {
 	response := c.createUpgradeObResponse()
 	if err = c.Execute(req, response); err != nil {
 		return nil, err
 	}
+	c.ResetAgentVersion()
 	return response.DagDetailDTO, nil
 }
 
@@ -106,5 +108,6 @@ func (c *Client) UpgradeObSyncWithRequest(req *UpgradeObRequest) (*model.DagDeta
 	if err != nil {
 		return nil, err
 	}
+	defer c.ResetAgentVersion()
 	return c.WaitDagSucceedWithRetry(dag.GenericID, 600)
 }
@@ -0,0 +1,171 @@
+/*
+ * Copyright (c) 2024 OceanBase.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/oceanbase/obshell-sdk-go/internal/util"
+	"github.com/oceanbase/obshell-sdk-go/model"
+	"github.com/oceanbase/obshell-sdk-go/sdk"
+	"github.com/oceanbase/obshell-sdk-go/sdk/auth"
+)
+
+const (
+	PRECHECK_REACHABLE    = "reachable"
+	PRECHECK_IDENTITY     = "identity"
+	PRECHECK_VERSION      = "version"
+	PRECHECK_AUTH_VERSION = "auth version"
+)
+
+// PrecheckAgents checks the agents before joining them into a cluster.
+// servers: the agents to be checked, in the format of 'ip:port'. The agent which the client connects to will always be checked.
+// It checks whether every agent is reachable, is SINGLE, runs the same version as the master(the build number is ignored)
+// and shares an auth version with the others.
+// A failed check never returns an error, it is recorded in the report.
+func (c *Client) PrecheckAgents(servers ...string) *model.PrecheckReport {
+	report := model.NewPrecheckReport()
+	if !util.ContainsString(servers, c.GetServer()) {
+		servers = append([]string{c.GetServer()}, servers...)
+	}
+
+	infos := make(map[string]*model.AgentRunStatus)
+	for _, server := range servers {
+		if info := precheckAgent(report, server); info != nil {
+			infos[server] = info
+		}
+	}
+
+	if master, ok := infos[c.GetServer()]; ok {
+		for _, server := range servers {
+			info, ok := infos[server]
+			if !ok {
+				continue
+			}
+			if util.CmpVersionString(strings.Split(info.Version, "-")[0], strings.Split(master.Version, "-")[0]) != 0 {
+				report.Fail(server, PRECHECK_VERSION, fmt.Sprintf("version %s is different from the master's %s", info.Version, master.Version))
+			} else {
+				report.Pass(server, PRECHECK_VERSION, info.Version)
+			}
+		}
+	}
+
+	precheckAuthVersion(report, servers, infos)
+	return report
+}
+
+// PrecheckCreateClusterRequest checks all the servers in the CreateClusterRequest, see PrecheckAgents.
+func (c *Client) PrecheckCreateClusterRequest(req *CreateClusterRequest) *model.PrecheckReport {
+	servers := make([]string, 0, len(req.server))
+	for server := range req.server {
+		servers = append(servers, server)
+	}
+	sort.Strings(servers)
+	return c.PrecheckAgents(servers...)
+}
+
+func precheckAgent(report *model.PrecheckReport, server string) *model.AgentRunStatus {
+	agentInfo, err := util.ParseAddr(server)
+	if err != nil {
+		report.Fail(server, PRECHECK_REACHABLE, "the format of server only can be 'ip:port' at present")
+		return nil
+	}
+	info, err := util.GetInfoWithTimeout(server, sdk.DEFAULT_AGENT_PROBE_TIMEOUT)
+	if err != nil {
+		report.Fail(server, PRECHECK_REACHABLE, err.Error())
+		return nil
+	}
+	client, err := NewClientWithServer(agentInfo.Ip, agentInfo.Port)
+	if err != nil {
+		report.Fail(server, PRECHECK_REACHABLE, err.Error())
+		return nil
+	}
+	client.SetTimeout(sdk.DEFAULT_AGENT_PROBE_TIMEOUT)
+	status, err := client.GetStatus()
+	if err != nil {
+		report.Fail(server, PRECHECK_REACHABLE, err.Error())
+		return nil
+	}
+	report.Pass(server, PRECHECK_REACHABLE, "")
+
+	if status.Agent.Identity != model.SINGLE {
+		report.Fail(server, PRECHECK_IDENTITY, fmt.Sprintf("identity is %s, expected %s", status.Agent.Identity, model.SINGLE))
+	} else if status.UnderMaintenance {
+		report.Fail(server, PRECHECK_IDENTITY, "agent is under maintenance")
+	} else {
+		report.Pass(server, PRECHECK_IDENTITY, string(model.SINGLE))
+	}
+	return info
+}
+
+// precheckAuthVersion checks whether all the agents share at least one auth version.
+func precheckAuthVersion(report *model.PrecheckReport, servers []string, infos map[string]*model.AgentRunStatus) {
+	var common []string
+	first := true
+	for _, server := range servers {
+		info, ok := infos[server]
+		if !ok {
+			continue
+		}
+		supported := supportedAuthVersions(info)
+		if len(supported) == 0 {
+			report.Fail(server, PRECHECK_AUTH_VERSION, fmt.Sprintf("no supported auth version for obshell %s", info.Version))
+			continue
+		}
+		if first {
+			common = supported
+			first = false
+			continue
+		}
+		intersection := make([]string, 0)
+		for _, v := range common {
+			if util.ContainsString(supported, v) {
+				intersection = append(intersection, v)
+			}
+		}
+		common = intersection
+	}
+	if first {
+		return
+	}
+
+	for _, server := range servers {
+		info, ok := infos[server]
+		if !ok || len(supportedAuthVersions(info)) == 0 {
+			continue
+		}
+		if len(common) == 0 {
+			report.Fail(server, PRECHECK_AUTH_VERSION, fmt.Sprintf("supported auth versions [%s] have no intersection with the other agents", strings.Join(supportedAuthVersions(info), ",")))
+		} else {
+			report.Pass(server, PRECHECK_AUTH_VERSION, strings.Join(common, ","))
+		}
+	}
+}
+
+func supportedAuthVersions(info *model.AgentRunStatus) []string {
+	if info.SupportedAuth != nil {
+		return info.SupportedAuth
+	}
+	if auth.VERSION_4_2_2.Equals(info.Version) {
+		return []string{auth.AUTH_V1}
+	} else if auth.VERSION_4_2_3.BeforeOrEquals(info.Version) {
+		return []string{auth.AUTH_V2}
+	}
+	return nil
+}
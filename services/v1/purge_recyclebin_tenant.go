@@ -26,6 +26,7 @@ import (
 
 type PurgeRecyclebinTenantRequest struct {
 	*request.BaseRequest
+	objectOrOriginalName string
 }
 
 type purgeRecyclebinTenantResponse struct {
@@ -42,7 +43,8 @@ func (c *Client) createPurgeRecyclebinTenantResponse() *purgeRecyclebinTenantRes
 // objectOrOriginalName: the name of the object(tenant) in recyclebin or the original name of the tenant.
 func (c *Client) NewPurgeRecyclebinTenantRequest(objectOrOriginalName string) *PurgeRecyclebinTenantRequest {
 	req := &PurgeRecyclebinTenantRequest{
-		BaseRequest: request.NewBaseRequest(),
+		BaseRequest:          request.NewBaseRequest(),
+		objectOrOriginalName: objectOrOriginalName,
 	}
 	req.SetAuthentication()
 	req.InitApiInfo(fmt.Sprintf("/api/v1/recyclebin/tenant/%s", objectOrOriginalName), c.GetHost(), c.GetPort(), "DELETE")
@@ -60,6 +62,9 @@ func (c *Client) PurgeRecyclebinTenant(tenantName string) (dag *model.DagDetailD
 // You can use WaitDagSucceed to wait for the task to complete.
 // You can check or operater the task through the DagDetailDTO.
 func (c *Client) PurgeRecyclebinTenantWithRequest(request *PurgeRecyclebinTenantRequest) (dag *model.DagDetailDTO, err error) {
+	if err = c.checkPurgeRecyclebinTenantGuardrail(request); err != nil {
+		return nil, err
+	}
 	response := c.createPurgeRecyclebinTenantResponse()
 	if err = c.Execute(request, response); err != nil {
 		return nil, err
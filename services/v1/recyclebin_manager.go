@@ -0,0 +1,184 @@
+/*
+ * Copyright (c) 2024 OceanBase.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/oceanbase/obshell-sdk-go/model"
+)
+
+// RecycledTenant is a tenant in recyclebin with its parsed drop time.
+type RecycledTenant struct {
+	model.RecycledTenantOverView
+	DroppedAt time.Time `json:"dropped_at"`
+}
+
+// RecyclebinRetentionResult is the result of applying a retention policy to the recyclebin.
+type RecyclebinRetentionResult struct {
+	DryRun    bool
+	Purged    []RecycledTenant // The tenants purged, or to be purged in dry-run mode.
+	Kept      []RecycledTenant // The tenants kept by the retention policy.
+	Protected []RecycledTenant // The tenants matching the allowlist.
+	Skipped   []RecycledTenant // The tenants which can not be purged.
+	Errors    map[string]error // The errors occurred when purging, keyed by object name.
+}
+
+// RecyclebinManager manages the retention of the tenants in recyclebin.
+type RecyclebinManager struct {
+	client    *Client
+	allowlist []string
+}
+
+// NewRecyclebinManager returns a RecyclebinManager.
+// allowlist: the patterns of the original tenant names which will never be purged, such as "prod_*", see path.Match for the syntax.
+func (c *Client) NewRecyclebinManager(allowlist ...string) *RecyclebinManager {
+	return &RecyclebinManager{
+		client:    c,
+		allowlist: allowlist,
+	}
+}
+
+// IsProtected reports whether the original tenant name matches the allowlist.
+func (m *RecyclebinManager) IsProtected(tenantName string) bool {
+	return matchPatterns(m.allowlist, tenantName) != ""
+}
+
+// List returns the tenants in recyclebin sorted by the drop time in descending order.
+func (m *RecyclebinManager) List() ([]RecycledTenant, error) {
+	overviews, err := m.client.GetAllRecyclebinTenants()
+	if err != nil {
+		return nil, err
+	}
+	tenants := make([]RecycledTenant, 0, len(overviews))
+	for _, overview := range overviews {
+		dropTime, err := overview.GetDropTime()
+		if err != nil {
+			return nil, err
+		}
+		tenants = append(tenants, RecycledTenant{RecycledTenantOverView: overview, DroppedAt: dropTime})
+	}
+	sort.SliceStable(tenants, func(i, j int) bool {
+		return tenants[i].DroppedAt.After(tenants[j].DroppedAt)
+	})
+	return tenants, nil
+}
+
+// PurgeOlderThan purges the tenants dropped more than the given days ago.
+// If dryRun is true, nothing will be purged and the result shows what would happen.
+func (m *RecyclebinManager) PurgeOlderThan(days int, dryRun bool) (*RecyclebinRetentionResult, error) {
+	deadline := time.Now().Add(-time.Duration(days) * 24 * time.Hour)
+	return m.applyRetention(dryRun, func(index int, tenant RecycledTenant) bool {
+		return tenant.DroppedAt.Before(deadline)
+	})
+}
+
+// KeepLast keeps the latest k dropped tenants and purges the others.
+// The protected tenants are not counted in k.
+// If dryRun is true, nothing will be purged and the result shows what would happen.
+func (m *RecyclebinManager) KeepLast(k int, dryRun bool) (*RecyclebinRetentionResult, error) {
+	return m.applyRetention(dryRun, func(index int, tenant RecycledTenant) bool {
+		return index >= k
+	})
+}
+
+// applyRetention purges the tenants which shouldPurge returns true for,
+// index is the position of the tenant in the unprotected tenants sorted by drop time in descending order.
+func (m *RecyclebinManager) applyRetention(dryRun bool, shouldPurge func(index int, tenant RecycledTenant) bool) (*RecyclebinRetentionResult, error) {
+	tenants, err := m.List()
+	if err != nil {
+		return nil, err
+	}
+
+	result := &RecyclebinRetentionResult{
+		DryRun: dryRun,
+		Errors: make(map[string]error),
+	}
+	index := 0
+	for _, tenant := range tenants {
+		if m.IsProtected(tenant.OriginalName) {
+			result.Protected = append(result.Protected, tenant)
+			continue
+		}
+		purge := shouldPurge(index, tenant)
+		index++
+		if !purge {
+			result.Kept = append(result.Kept, tenant)
+			continue
+		}
+		if !tenant.IsPurgeable() {
+			result.Skipped = append(result.Skipped, tenant)
+			continue
+		}
+		if !dryRun {
+			if _, err := m.client.PurgeRecyclebinTenant(tenant.Name); err != nil {
+				result.Errors[tenant.Name] = err
+				continue
+			}
+		}
+		result.Purged = append(result.Purged, tenant)
+	}
+	return result, nil
+}
+
+// Flashback flashbacks a tenant from recyclebin and returns the name of the restored tenant.
+// objectOrOriginalName: the name of the object in recyclebin or the original name of the tenant.
+// newName: the new name of the tenant, optional.
+// If newName is empty and the original name has been reused by another tenant,
+// the tenant will be renamed to '{original_name}_{drop_time}' such as 't1_20240102150405'.
+func (m *RecyclebinManager) Flashback(objectOrOriginalName string, newName string) (string, error) {
+	tenants, err := m.List()
+	if err != nil {
+		return "", err
+	}
+	var target *RecycledTenant
+	for i := range tenants {
+		// The latest dropped one is preferred when matching by the original name.
+		if tenants[i].Name == objectOrOriginalName || tenants[i].OriginalName == objectOrOriginalName {
+			target = &tenants[i]
+			break
+		}
+	}
+	if target == nil {
+		return "", fmt.Errorf("tenant '%s' is not in recyclebin", objectOrOriginalName)
+	}
+	if !target.CanFlashback() {
+		return "", fmt.Errorf("tenant '%s' can not be flashback", objectOrOriginalName)
+	}
+
+	if newName == "" {
+		// Check the name by the tenant list, so that an error of the query is never considered as the name is free.
+		overviews, err := m.client.GetAllTenantOverview()
+		if err != nil {
+			return "", err
+		}
+		for _, overview := range overviews {
+			if overview.Name == target.OriginalName {
+				newName = fmt.Sprintf("%s_%s", target.OriginalName, target.DroppedAt.Format("20060102150405"))
+				break
+			}
+		}
+	}
+	if newName == "" {
+		err = m.client.FlashbackRecyclebinTenant(target.Name)
+		return target.OriginalName, err
+	}
+	err = m.client.FlashbackRecyclebinTenant(target.Name, newName)
+	return newName, err
+}
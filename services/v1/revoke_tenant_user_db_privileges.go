@@ -0,0 +1,70 @@
+/*
+ * Copyright (c) 2024 OceanBase.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1
+
+import (
+	"fmt"
+
+	"github.com/oceanbase/obshell-sdk-go/model"
+	"github.com/oceanbase/obshell-sdk-go/sdk/request"
+	"github.com/oceanbase/obshell-sdk-go/sdk/response"
+)
+
+type RevokeTenantUserDbPrivilegesRequest struct {
+	*request.BaseRequest
+}
+
+type revokeTenantUserDbPrivilegesResponse struct {
+	*response.OcsAgentResponse
+}
+
+func (c *Client) createRevokeTenantUserDbPrivilegesResponse() *revokeTenantUserDbPrivilegesResponse {
+	return &revokeTenantUserDbPrivilegesResponse{
+		OcsAgentResponse: response.NewOcsAgentResponseWithoutReturn(),
+	}
+}
+
+// NewRevokeTenantUserDbPrivilegesRequest return a RevokeTenantUserDbPrivilegesRequest, which can be used as the argument for the RevokeTenantUserDbPrivilegesWithRequest.
+// tenantName: the name of the tenant.
+// userName: the name of the user.
+// privileges: the database-level privileges to be revoked.
+func (c *Client) NewRevokeTenantUserDbPrivilegesRequest(tenantName string, userName string, privileges []model.DbPrivilege) *RevokeTenantUserDbPrivilegesRequest {
+	req := &RevokeTenantUserDbPrivilegesRequest{
+		BaseRequest: request.NewBaseRequest(),
+	}
+	req.SetBody(map[string]interface{}{
+		"db_privileges": privileges,
+	})
+	req.SetAuthentication()
+	req.InitApiInfo(fmt.Sprintf("/api/v1/tenant/%s/user/%s/db-privileges", tenantName, userName), c.GetHost(), c.GetPort(), "DELETE")
+	return req
+}
+
+// RevokeTenantUserDbPrivileges revokes the database-level privileges of a user of the tenant.
+// tenantName: the name of the tenant.
+// userName: the name of the user.
+// privileges: the database-level privileges to be revoked.
+func (c *Client) RevokeTenantUserDbPrivileges(tenantName string, userName string, privileges []model.DbPrivilege) error {
+	request := c.NewRevokeTenantUserDbPrivilegesRequest(tenantName, userName, privileges)
+	return c.RevokeTenantUserDbPrivilegesWithRequest(request)
+}
+
+// RevokeTenantUserDbPrivilegesWithRequest revokes the database-level privileges of a user of the tenant with a RevokeTenantUserDbPrivilegesRequest.
+func (c *Client) RevokeTenantUserDbPrivilegesWithRequest(request *RevokeTenantUserDbPrivilegesRequest) error {
+	response := c.createRevokeTenantUserDbPrivilegesResponse()
+	return c.Execute(request, response)
+}
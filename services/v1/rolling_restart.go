@@ -0,0 +1,280 @@
+/*
+ * Copyright (c) 2024 OceanBase.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/oceanbase/obshell-sdk-go/model"
+)
+
+const (
+	ROLLING_RESTART_STOP   = "STOP"
+	ROLLING_RESTART_START  = "START"
+	ROLLING_RESTART_HEALTH = "HEALTH"
+
+	defaultHealthTimeout  = 10 * time.Minute
+	defaultHealthInterval = 5 * time.Second
+)
+
+var (
+	ErrRollingRestartAborted   = errors.New("rolling restart is aborted")
+	ErrRollingRestartUnhealthy = errors.New("servers are not healthy")
+)
+
+// RollingRestartStep describes a step of the rolling restart.
+type RollingRestartStep struct {
+	Zone    string
+	Targets []string // The zone name for SCOPE_ZONE, or the servers('ip:port') for SCOPE_SERVER.
+	Action  string   // ROLLING_RESTART_STOP, ROLLING_RESTART_START or ROLLING_RESTART_HEALTH.
+}
+
+// RollingRestartOptions is the options of RollingRestart.
+type RollingRestartOptions struct {
+	// Granularity can be SCOPE_ZONE or SCOPE_SERVER, default is SCOPE_ZONE.
+	Granularity string
+	// Zones is the restart order of the zones, default is all the zones in alphabetical order.
+	Zones []string
+	// HealthCheck is the custom health gate after the servers are all ACTIVE, optional.
+	// servers: the servers('ip:port') which are just restarted.
+	HealthCheck func(c *Client, zone string, servers []string) error
+	// HealthTimeout is the timeout to wait for the servers to be healthy, default is 10 minutes.
+	HealthTimeout time.Duration
+	// HealthInterval is the interval to check the health, default is 5 seconds.
+	HealthInterval time.Duration
+	// OnDag will be called when the task of a step is requested and when it is finished, optional.
+	OnDag func(step RollingRestartStep, dag *model.DagDetailDTO)
+	// OnStep will be called before a step is executed, optional.
+	OnStep func(step RollingRestartStep)
+}
+
+// RollingRestart restarts the cluster zone by zone(or server by server),
+// the next zone will not be restarted until the servers of the current zone are healthy.
+// It can be paused, resumed or aborted between the steps.
+type RollingRestart struct {
+	client *Client
+	opts   RollingRestartOptions
+
+	mu      sync.Mutex
+	cond    *sync.Cond
+	paused  bool
+	aborted bool
+}
+
+// NewRollingRestart returns a RollingRestart, call Run to start the rolling restart.
+func (c *Client) NewRollingRestart(opts RollingRestartOptions) *RollingRestart {
+	if opts.Granularity == "" {
+		opts.Granularity = SCOPE_ZONE
+	}
+	if opts.HealthTimeout <= 0 {
+		opts.HealthTimeout = defaultHealthTimeout
+	}
+	if opts.HealthInterval <= 0 {
+		opts.HealthInterval = defaultHealthInterval
+	}
+	r := &RollingRestart{
+		client: c,
+		opts:   opts,
+	}
+	r.cond = sync.NewCond(&r.mu)
+	return r
+}
+
+// RollingRestart restarts the cluster zone by zone(or server by server) synchronously.
+// It returns an error once any step is failed, and the remaining zones will not be restarted.
+func (c *Client) RollingRestart(opts RollingRestartOptions) error {
+	return c.NewRollingRestart(opts).Run()
+}
+
+// Pause pauses the rolling restart before the next step.
+func (r *RollingRestart) Pause() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.paused = true
+}
+
+// Resume resumes the paused rolling restart.
+func (r *RollingRestart) Resume() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.paused = false
+	r.cond.Broadcast()
+}
+
+// Abort aborts the rolling restart before the next step, Run will return ErrRollingRestartAborted.
+// The running step will not be interrupted.
+func (r *RollingRestart) Abort() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.aborted = true
+	r.cond.Broadcast()
+}
+
+// IsPaused returns whether the rolling restart is paused.
+func (r *RollingRestart) IsPaused() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.paused
+}
+
+// waitIfPaused blocks until the rolling restart is resumed or aborted.
+func (r *RollingRestart) waitIfPaused() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for r.paused && !r.aborted {
+		r.cond.Wait()
+	}
+	if r.aborted {
+		return ErrRollingRestartAborted
+	}
+	return nil
+}
+
+// Run executes the rolling restart.
+func (r *RollingRestart) Run() error {
+	if r.opts.Granularity != SCOPE_ZONE && r.opts.Granularity != SCOPE_SERVER {
+		return fmt.Errorf("unsupported granularity '%s'", r.opts.Granularity)
+	}
+	obInfo, err := r.client.GetObInfo()
+	if err != nil {
+		return err
+	}
+	topology := obInfo.Config.ZoneConfig
+	zones := r.opts.Zones
+	if len(zones) == 0 {
+		zones = obInfo.Config.Zones()
+		sort.Strings(zones)
+	} else {
+		for _, zone := range zones {
+			if _, ok := topology[zone]; !ok {
+				return fmt.Errorf("zone '%s' does not exist in cluster", zone)
+			}
+		}
+	}
+
+	for _, zone := range zones {
+		if r.opts.Granularity == SCOPE_ZONE {
+			servers := make([]string, 0, len(topology[zone]))
+			for _, server := range topology[zone] {
+				servers = append(servers, server.AgentAddr())
+			}
+			if err = r.restart(zone, []string{zone}, servers); err != nil {
+				return err
+			}
+			continue
+		}
+		for _, server := range topology[zone] {
+			if err = r.restart(zone, []string{server.AgentAddr()}, []string{server.AgentAddr()}); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// restart stops and starts the targets, then waits for the servers to be healthy.
+func (r *RollingRestart) restart(zone string, targets []string, servers []string) error {
+	stopStep := RollingRestartStep{Zone: zone, Targets: targets, Action: ROLLING_RESTART_STOP}
+	if err := r.runDagStep(stopStep, func() (*model.DagDetailDTO, error) {
+		return r.client.StopWithRequest(r.client.NewStopRequest(r.opts.Granularity, targets...))
+	}); err != nil {
+		return err
+	}
+
+	startStep := RollingRestartStep{Zone: zone, Targets: targets, Action: ROLLING_RESTART_START}
+	if err := r.runDagStep(startStep, func() (*model.DagDetailDTO, error) {
+		return r.client.StartWithRequest(r.client.NewStartRequest(r.opts.Granularity, targets...))
+	}); err != nil {
+		return err
+	}
+
+	healthStep := RollingRestartStep{Zone: zone, Targets: targets, Action: ROLLING_RESTART_HEALTH}
+	if err := r.waitIfPaused(); err != nil {
+		return err
+	}
+	if r.opts.OnStep != nil {
+		r.opts.OnStep(healthStep)
+	}
+	return r.waitHealthy(zone, servers)
+}
+
+func (r *RollingRestart) runDagStep(step RollingRestartStep, request func() (*model.DagDetailDTO, error)) error {
+	if err := r.waitIfPaused(); err != nil {
+		return err
+	}
+	if r.opts.OnStep != nil {
+		r.opts.OnStep(step)
+	}
+	dag, err := request()
+	if err != nil {
+		return errors.Wrapf(err, "%s %v failed", step.Action, step.Targets)
+	}
+	if dag == nil || dag.GenericDTO == nil {
+		return nil
+	}
+	r.notifyDag(step, dag)
+	dag, err = r.client.WaitDagSucceed(dag.GenericID)
+	r.notifyDag(step, dag)
+	if err != nil {
+		return errors.Wrapf(err, "%s %v failed", step.Action, step.Targets)
+	}
+	return nil
+}
+
+func (r *RollingRestart) notifyDag(step RollingRestartStep, dag *model.DagDetailDTO) {
+	if r.opts.OnDag != nil && dag != nil {
+		r.opts.OnDag(step, dag)
+	}
+}
+
+// waitHealthy waits until all the servers are ACTIVE and the custom health check passes.
+func (r *RollingRestart) waitHealthy(zone string, servers []string) error {
+	deadline := time.Now().Add(r.opts.HealthTimeout)
+	var lastErr error
+	for {
+		if lastErr = r.checkHealth(zone, servers); lastErr == nil {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return errors.Wrapf(ErrRollingRestartUnhealthy, "zone '%s': %v", zone, lastErr)
+		}
+		time.Sleep(r.opts.HealthInterval)
+	}
+}
+
+func (r *RollingRestart) checkHealth(zone string, servers []string) error {
+	obInfo, err := r.client.GetObInfo()
+	if err != nil {
+		return err
+	}
+	for _, server := range obInfo.Config.ZoneConfig[zone] {
+		for _, target := range servers {
+			if server.AgentAddr() == target && !server.IsActive() {
+				return fmt.Errorf("server '%s' is %s", target, server.Status)
+			}
+		}
+	}
+	if r.opts.HealthCheck != nil {
+		return r.opts.HealthCheck(r.client, zone, servers)
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+/*
+ * Copyright (c) 2024 OceanBase.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1
+
+import (
+	"fmt"
+
+	"github.com/oceanbase/obshell-sdk-go/sdk/request"
+	"github.com/oceanbase/obshell-sdk-go/sdk/response"
+)
+
+type SetTenantUserPasswordRequest struct {
+	*request.BaseRequest
+}
+
+type setTenantUserPasswordResponse struct {
+	*response.OcsAgentResponse
+}
+
+func (c *Client) createSetTenantUserPasswordResponse() *setTenantUserPasswordResponse {
+	return &setTenantUserPasswordResponse{
+		OcsAgentResponse: response.NewOcsAgentResponseWithoutReturn(),
+	}
+}
+
+// NewSetTenantUserPasswordRequest return a SetTenantUserPasswordRequest, which can be used as the argument for the SetTenantUserPasswordWithRequest.
+// tenantName: the name of the tenant.
+// userName: the name of the user, use SetTenantRootPassword for root.
+// newPassword: the new password of the user, it will be encrypted with the request body as SetTenantRootPassword.
+func (c *Client) NewSetTenantUserPasswordRequest(tenantName string, userName string, newPassword string) *SetTenantUserPasswordRequest {
+	req := &SetTenantUserPasswordRequest{
+		BaseRequest: request.NewBaseRequest(),
+	}
+	req.SetBody(
+		map[string]string{
+			"new_password": newPassword,
+		},
+	)
+	req.SetAuthentication()
+	req.InitApiInfo(fmt.Sprintf("/api/v1/tenant/%s/user/%s/password", tenantName, userName), c.GetHost(), c.GetPort(), "PUT")
+	return req
+}
+
+// SetTenantUserPassword sets the password of a user of the tenant.
+func (c *Client) SetTenantUserPassword(tenantName, userName, newPassword string) error {
+	request := c.NewSetTenantUserPasswordRequest(tenantName, userName, newPassword)
+	return c.SetTenantUserPasswordWithRequest(request)
+}
+
+// SetTenantUserPasswordWithRequest sets the password of a user of the tenant with a SetTenantUserPasswordRequest.
+func (c *Client) SetTenantUserPasswordWithRequest(request *SetTenantUserPasswordRequest) error {
+	response := c.createSetTenantUserPasswordResponse()
+	return c.Execute(request, response)
+}
@@ -0,0 +1,143 @@
+/*
+ * Copyright (c) 2024 OceanBase.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1
+
+import (
+	"encoding/json"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/oceanbase/obshell-sdk-go/internal/util"
+	"github.com/oceanbase/obshell-sdk-go/model"
+)
+
+// AgentSnapshot is the inventory of an agent.
+type AgentSnapshot struct {
+	model.AgentInstance
+	Status  *model.AgentStatus    `json:"status,omitempty"`
+	Info    *model.AgentRunStatus `json:"info,omitempty"`
+	GitInfo *model.GitInfo        `json:"git_info,omitempty"`
+	Errors  []string              `json:"errors,omitempty"` // The errors occurred when querying the agent.
+}
+
+// ClusterSnapshot is the inventory of a cluster at a moment.
+type ClusterSnapshot struct {
+	Timestamp     time.Time                  `json:"timestamp"`
+	Server        string                     `json:"server"` // The agent which the snapshot is taken from.
+	Cluster       model.ClusterConfig        `json:"cluster"`
+	Agents        []*AgentSnapshot           `json:"agents"`
+	Tenants       []model.TenantOverview     `json:"tenants"`
+	ResourcePools []model.ResourcePoolInfo   `json:"resource_pools"`
+	UnitConfigs   []model.ResourceUnitConfig `json:"unit_configs"`
+	Errors        []string                   `json:"errors,omitempty"` // The errors occurred when querying the cluster.
+}
+
+// JSON renders the snapshot as indented JSON.
+func (s *ClusterSnapshot) JSON() ([]byte, error) {
+	return json.MarshalIndent(s, "", "  ")
+}
+
+// YAML renders the snapshot as YAML, the keys are sorted so that the snapshots can be diffed.
+func (s *ClusterSnapshot) YAML() ([]byte, error) {
+	return util.MarshalYAML(s)
+}
+
+// Snapshot takes an inventory of the cluster, including the agents, versions, identities,
+// the zone/server topology, tenants, resource pools and unit configs.
+// The agents are queried concurrently. Only the failure of GetObInfo returns an error,
+// the other failures are recorded in the Errors of the snapshot.
+func (c *Client) Snapshot() (*ClusterSnapshot, error) {
+	obInfo, err := c.GetObInfo()
+	if err != nil {
+		return nil, err
+	}
+	snapshot := &ClusterSnapshot{
+		Timestamp:     time.Now(),
+		Server:        c.GetServer(),
+		Cluster:       obInfo.Config,
+		Agents:        make([]*AgentSnapshot, len(obInfo.Agents)),
+		Tenants:       make([]model.TenantOverview, 0),
+		ResourcePools: make([]model.ResourcePoolInfo, 0),
+		UnitConfigs:   make([]model.ResourceUnitConfig, 0),
+	}
+
+	var wg sync.WaitGroup
+	for i, agent := range obInfo.Agents {
+		// The clients of the agents are created before the goroutines, as the client is not thread-safe.
+		client, err := c.newAgentClient(agent.Ip, agent.Port)
+		if err != nil {
+			snapshot.Agents[i] = &AgentSnapshot{AgentInstance: agent, Errors: []string{err.Error()}}
+			continue
+		}
+		wg.Add(1)
+		go func(i int, agent model.AgentInstance, client *Client) {
+			defer wg.Done()
+			snapshot.Agents[i] = snapshotAgent(agent, client)
+		}(i, agent, client)
+	}
+
+	// The client is not thread-safe, so the cluster level queries are executed sequentially.
+	addError := func(err error) {
+		snapshot.Errors = append(snapshot.Errors, err.Error())
+	}
+	if tenants, err := c.GetAllTenantOverview(); err != nil {
+		addError(err)
+	} else {
+		snapshot.Tenants = tenants
+	}
+	if pools, err := c.GetAllResourcePools(); err != nil {
+		addError(err)
+	} else {
+		snapshot.ResourcePools = pools
+	}
+	if unitConfigs, err := c.GetAllUnitConfigs(); err != nil {
+		addError(err)
+	} else {
+		snapshot.UnitConfigs = unitConfigs
+	}
+	wg.Wait()
+
+	sort.Slice(snapshot.Agents, func(i, j int) bool {
+		return snapshot.Agents[i].String() < snapshot.Agents[j].String()
+	})
+	sort.Slice(snapshot.Tenants, func(i, j int) bool {
+		return snapshot.Tenants[i].Name < snapshot.Tenants[j].Name
+	})
+	sort.Slice(snapshot.ResourcePools, func(i, j int) bool {
+		return snapshot.ResourcePools[i].Name < snapshot.ResourcePools[j].Name
+	})
+	sort.Slice(snapshot.UnitConfigs, func(i, j int) bool {
+		return snapshot.UnitConfigs[i].Name < snapshot.UnitConfigs[j].Name
+	})
+	return snapshot, nil
+}
+
+func snapshotAgent(agent model.AgentInstance, client *Client) *AgentSnapshot {
+	snapshot := &AgentSnapshot{AgentInstance: agent}
+	var err error
+	if snapshot.Status, err = client.GetStatus(); err != nil {
+		snapshot.Errors = append(snapshot.Errors, err.Error())
+	}
+	if snapshot.Info, err = client.GetInfo(); err != nil {
+		snapshot.Errors = append(snapshot.Errors, err.Error())
+	}
+	if snapshot.GitInfo, err = client.GetGitInfo(); err != nil {
+		snapshot.Errors = append(snapshot.Errors, err.Error())
+	}
+	return snapshot
+}
@@ -0,0 +1,101 @@
+/*
+ * Copyright (c) 2024 OceanBase.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1
+
+import (
+	"fmt"
+
+	"github.com/oceanbase/obshell-sdk-go/model"
+	"github.com/oceanbase/obshell-sdk-go/sdk/request"
+	"github.com/oceanbase/obshell-sdk-go/sdk/response"
+)
+
+type SplitResourcePoolRequest struct {
+	*request.BaseRequest
+	poolName string
+	param    SplitResourcePoolParam
+}
+
+type SplitResourcePoolParam struct {
+	Targets []model.ResourcePoolSplitTarget `json:"targets" binding:"required"` // Every zone of the pool should be taken by exactly one target.
+}
+
+type SplitResourcePoolResponse struct {
+	*response.TaskResponse
+}
+
+func (c *Client) createSplitResourcePoolResponse() *SplitResourcePoolResponse {
+	return &SplitResourcePoolResponse{
+		TaskResponse: response.NewTaskResponse(),
+	}
+}
+
+// NewSplitResourcePoolRequest return a SplitResourcePoolRequest, which can be used as the argument for the SplitResourcePoolWithRequest/SplitResourcePoolSyncWithRequest.
+// poolName: the name of the resource pool to be split.
+// targets: the new pools, each of them takes one zone of the original pool.
+// The original pool will be dropped after splitting, and the tenant using it will use the new pools instead.
+func (c *Client) NewSplitResourcePoolRequest(poolName string, targets []model.ResourcePoolSplitTarget) *SplitResourcePoolRequest {
+	req := &SplitResourcePoolRequest{
+		BaseRequest: request.NewAsyncBaseRequest(),
+		poolName:    poolName,
+		param: SplitResourcePoolParam{
+			Targets: targets,
+		},
+	}
+	req.SetBody(&req.param)
+	req.SetAuthentication()
+	req.InitApiInfo(fmt.Sprintf("/api/v1/resource-pool/%s/split", poolName), c.GetHost(), c.GetPort(), "POST")
+	return req
+}
+
+// SplitResourcePool returns a DagDetailDTO and an error, when the task is completed successfully, the error will be nil.
+// poolName: the name of the resource pool to be split.
+// targets: the new pools, each of them takes one zone of the original pool.
+func (c *Client) SplitResourcePool(poolName string, targets []model.ResourcePoolSplitTarget) (*model.DagDetailDTO, error) {
+	request := c.NewSplitResourcePoolRequest(poolName, targets)
+	return c.SplitResourcePoolSyncWithRequest(request)
+}
+
+// SplitResourcePoolWithRequest returns a DagDetailDTO and an error, when the task is requested successfully, the error will be nil.
+// the parameter is a SplitResourcePoolRequest, which can be created by NewSplitResourcePoolRequest.
+// You can use WaitDagSucceed to wait for the task to complete.
+// You can check or operater the task through the DagDetailDTO.
+func (c *Client) SplitResourcePoolWithRequest(request *SplitResourcePoolRequest) (dag *model.DagDetailDTO, err error) {
+	if err = c.checkSplitResourcePoolGuardrail(request); err != nil {
+		return nil, err
+	}
+	response := c.createSplitResourcePoolResponse()
+	if err = c.Execute(request, response); err != nil {
+		return nil, err
+	}
+	return response.DagDetailDTO, nil
+}
+
+// SplitResourcePoolSyncWithRequest returns a DagDetailDTO and an error, when the task is completed successfully, the error will be nil.
+// the DagDetailDTO is the final status of the task.
+// the parameter is a SplitResourcePoolRequest, which can be created by NewSplitResourcePoolRequest.
+// You can check or operater the task through the DagDetailDTO.
+// If the agent splits the resource pool synchronously, the DagDetailDTO will be nil.
+func (c *Client) SplitResourcePoolSyncWithRequest(request *SplitResourcePoolRequest) (dag *model.DagDetailDTO, err error) {
+	if dag, err = c.SplitResourcePoolWithRequest(request); err != nil {
+		return nil, err
+	}
+	if dag == nil || dag.GenericDTO == nil {
+		return nil, nil
+	}
+	return c.WaitDagSucceed(dag.GenericID)
+}
@@ -0,0 +1,87 @@
+/*
+ * Copyright (c) 2024 OceanBase.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1
+
+import (
+	"fmt"
+
+	"github.com/oceanbase/obshell-sdk-go/model"
+	"github.com/oceanbase/obshell-sdk-go/sdk/request"
+	"github.com/oceanbase/obshell-sdk-go/sdk/response"
+)
+
+type SwitchoverTenantRequest struct {
+	*request.BaseRequest
+}
+
+type SwitchoverTenantResponse struct {
+	*response.TaskResponse
+}
+
+func (c *Client) createSwitchoverTenantResponse() *SwitchoverTenantResponse {
+	return &SwitchoverTenantResponse{
+		TaskResponse: response.NewTaskResponse(),
+	}
+}
+
+// NewSwitchoverTenantRequest return a SwitchoverTenantRequest, which can be used as the argument for the SwitchoverTenantWithRequest/SwitchoverTenantSyncWithRequest.
+// tenantName: the name of the tenant.
+// targetRole: the role the tenant switches to, model.TENANT_ROLE_PRIMARY for a standby tenant or model.TENANT_ROLE_STANDBY for a primary tenant.
+// Switchover is lossless, switch the primary tenant to standby first, and then switch the standby tenant to primary.
+func (c *Client) NewSwitchoverTenantRequest(tenantName string, targetRole model.TenantRole) *SwitchoverTenantRequest {
+	req := &SwitchoverTenantRequest{
+		BaseRequest: request.NewAsyncBaseRequest(),
+	}
+	req.SetBody(map[string]interface{}{
+		"tenant_role": targetRole,
+	})
+	req.SetAuthentication()
+	req.InitApiInfo(fmt.Sprintf("/api/v1/tenant/%s/switchover", tenantName), c.GetHost(), c.GetPort(), "POST")
+	return req
+}
+
+// SwitchoverTenant returns a DagDetailDTO and an error, when the task is completed successfully, the error will be nil.
+// tenantName: the name of the tenant.
+// targetRole: the role the tenant switches to.
+func (c *Client) SwitchoverTenant(tenantName string, targetRole model.TenantRole) (*model.DagDetailDTO, error) {
+	request := c.NewSwitchoverTenantRequest(tenantName, targetRole)
+	return c.SwitchoverTenantSyncWithRequest(request)
+}
+
+// SwitchoverTenantWithRequest returns a DagDetailDTO and an error, when the task is requested successfully, the error will be nil.
+// the parameter is a SwitchoverTenantRequest, which can be created by NewSwitchoverTenantRequest.
+// You can use WaitDagSucceed to wait for the task to complete.
+// You can check or operater the task through the DagDetailDTO.
+func (c *Client) SwitchoverTenantWithRequest(request *SwitchoverTenantRequest) (dag *model.DagDetailDTO, err error) {
+	response := c.createSwitchoverTenantResponse()
+	if err = c.Execute(request, response); err != nil {
+		return nil, err
+	}
+	return response.DagDetailDTO, nil
+}
+
+// SwitchoverTenantSyncWithRequest returns a DagDetailDTO and an error, when the task is completed successfully, the error will be nil.
+// the DagDetailDTO is the final status of the task.
+// the parameter is a SwitchoverTenantRequest, which can be created by NewSwitchoverTenantRequest.
+// You can check or operater the task through the DagDetailDTO.
+func (c *Client) SwitchoverTenantSyncWithRequest(request *SwitchoverTenantRequest) (*model.DagDetailDTO, error) {
+	dag, err := c.SwitchoverTenantWithRequest(request)
+	if err != nil {
+		return nil, err
+	}
+	return c.WaitDagSucceed(dag.GenericID)
+}
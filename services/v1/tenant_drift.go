@@ -0,0 +1,263 @@
+/*
+ * Copyright (c) 2024 OceanBase.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/oceanbase/obshell-sdk-go/internal/util"
+	"github.com/oceanbase/obshell-sdk-go/model"
+)
+
+const (
+	DRIFT_KIND_PARAMETER = "PARAMETER"
+	DRIFT_KIND_VARIABLE  = "VARIABLE"
+)
+
+// DriftBaseline is the expected tenant parameters and variables.
+type DriftBaseline struct {
+	Parameters     map[string]interface{} `json:"parameters"`
+	Variables      map[string]interface{} `json:"variables"`
+	ExcludeTenants []string               `json:"exclude_tenants"` // Tenants not to be scanned, such as "sys".
+}
+
+// DriftItem is a parameter or variable of a tenant which deviates from the baseline.
+type DriftItem struct {
+	Kind      string `json:"kind"` // DRIFT_KIND_PARAMETER or DRIFT_KIND_VARIABLE.
+	Name      string `json:"name"`
+	Expected  string `json:"expected"`
+	Actual    string `json:"actual"`
+	DataType  string `json:"data_type,omitempty"`  // Only for parameter.
+	EditLevel string `json:"edit_level,omitempty"` // Only for parameter.
+	Missing   bool   `json:"missing"`              // The tenant does not have this parameter or variable.
+	Fixed     bool   `json:"fixed"`                // Set back to the baseline by FixTenantDrift.
+	// Set back to the baseline by FixTenantDrift, but the parameter(EDIT_LEVEL_STATIC_EFFECTIVE)
+	// only takes effect after the observers are restarted.
+	PendingRestart bool `json:"pending_restart"`
+}
+
+func (item *DriftItem) fixState() string {
+	if item.PendingRestart {
+		return "pending restart"
+	}
+	return fmt.Sprint(item.Fixed)
+}
+
+// TenantDrift is all the drift items of a tenant.
+type TenantDrift struct {
+	TenantName string      `json:"tenant_name"`
+	Items      []DriftItem `json:"items"`
+	Error      string      `json:"error,omitempty"`     // The error occurred when scanning the tenant.
+	FixError   string      `json:"fix_error,omitempty"` // The errors occurred when fixing the tenant.
+	Fixed      bool        `json:"fixed"`               // All the items are fixed and take effect.
+}
+
+// DriftReport is the result of DetectTenantDrift.
+type DriftReport struct {
+	Tenants []*TenantDrift `json:"tenants"`
+}
+
+// HasDrift reports whether any tenant deviates from the baseline.
+func (r *DriftReport) HasDrift() bool {
+	for _, tenant := range r.Tenants {
+		if len(tenant.Items) != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// JSON returns the report in indented JSON form.
+func (r *DriftReport) JSON() ([]byte, error) {
+	return json.MarshalIndent(r, "", "  ")
+}
+
+// Table returns the report in table form, one row for each drift item.
+// The ERROR column is the scanning error of the tenant, or the fixing error of the items not fixed.
+func (r *DriftReport) Table() string {
+	var buf bytes.Buffer
+	w := tabwriter.NewWriter(&buf, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "TENANT\tKIND\tNAME\tEXPECTED\tACTUAL\tDATA_TYPE\tEDIT_LEVEL\tFIXED\tERROR")
+	for _, tenant := range r.Tenants {
+		if tenant.Error != "" {
+			fmt.Fprintf(w, "%s\t-\t-\t-\t-\t-\t-\t-\t%s\n", tenant.TenantName, tenant.Error)
+			continue
+		}
+		for _, item := range tenant.Items {
+			actual := item.Actual
+			if item.Missing {
+				actual = "<missing>"
+			}
+			fixError := "-"
+			if !item.Missing && !item.Fixed && !item.PendingRestart && tenant.FixError != "" {
+				fixError = tenant.FixError
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n", tenant.TenantName, item.Kind, item.Name, item.Expected, actual, item.DataType, item.EditLevel, item.fixState(), fixError)
+		}
+	}
+	w.Flush()
+	return buf.String()
+}
+
+// DetectTenantDrift scans every tenant and compares its parameters and variables with the baseline.
+// Scanning errors of a single tenant are recorded in the report instead of being returned.
+func (c *Client) DetectTenantDrift(baseline DriftBaseline) (*DriftReport, error) {
+	tenants, err := c.GetAllTenantOverview()
+	if err != nil {
+		return nil, err
+	}
+
+	report := &DriftReport{Tenants: make([]*TenantDrift, 0, len(tenants))}
+	for _, tenant := range tenants {
+		if util.ContainsString(baseline.ExcludeTenants, tenant.Name) {
+			continue
+		}
+		drift := &TenantDrift{TenantName: tenant.Name, Items: make([]DriftItem, 0)}
+		if err := c.detectTenantDrift(drift, &baseline); err != nil {
+			drift.Error = err.Error()
+		}
+		report.Tenants = append(report.Tenants, drift)
+	}
+	return report, nil
+}
+
+func (c *Client) detectTenantDrift(drift *TenantDrift, baseline *DriftBaseline) error {
+	if len(baseline.Parameters) != 0 {
+		parameters, err := c.GetTenantParameters(drift.TenantName)
+		if err != nil {
+			return err
+		}
+		parameterMap := make(map[string]model.ParameterInfo, len(parameters))
+		for _, parameter := range parameters {
+			parameterMap[parameter.Name] = parameter
+		}
+		for _, name := range sortedKeys(baseline.Parameters) {
+			item := DriftItem{Kind: DRIFT_KIND_PARAMETER, Name: name, Expected: fmt.Sprint(baseline.Parameters[name])}
+			if parameter, ok := parameterMap[name]; !ok {
+				item.Missing = true
+			} else if !model.EqualParameterValue(parameter.DataType, item.Expected, parameter.Value) {
+				item.Actual = parameter.Value
+				item.DataType = parameter.DataType
+				item.EditLevel = parameter.EditLevel
+			} else {
+				continue
+			}
+			drift.Items = append(drift.Items, item)
+		}
+	}
+
+	if len(baseline.Variables) != 0 {
+		variables, err := c.GetTenantVariables(drift.TenantName)
+		if err != nil {
+			return err
+		}
+		variableMap := make(map[string]model.VariableInfo, len(variables))
+		for _, variable := range variables {
+			variableMap[variable.Name] = variable
+		}
+		for _, name := range sortedKeys(baseline.Variables) {
+			item := DriftItem{Kind: DRIFT_KIND_VARIABLE, Name: name, Expected: fmt.Sprint(baseline.Variables[name])}
+			if variable, ok := variableMap[name]; !ok {
+				item.Missing = true
+			} else if !model.EqualParameterValue(model.PARAMETER_DATA_TYPE_DOUBLE, item.Expected, variable.Value) {
+				// Variables have no data type, compare them as numbers first and then as strings.
+				item.Actual = variable.Value
+			} else {
+				continue
+			}
+			drift.Items = append(drift.Items, item)
+		}
+	}
+	return nil
+}
+
+// FixTenantDrift sets the drifted parameters and variables of every tenant in the report back to the baseline.
+// The missing items are skipped, and the result of each item and each tenant is recorded in the report.
+// The parameters of EDIT_LEVEL_STATIC_EFFECTIVE are marked as pending restart instead of fixed.
+// A tenant is marked as fixed only when all of its items are fixed.
+func (c *Client) FixTenantDrift(report *DriftReport, baseline DriftBaseline) {
+	for _, drift := range report.Tenants {
+		if drift.Error != "" || len(drift.Items) == 0 {
+			continue
+		}
+		parameters := make(map[string]interface{})
+		variables := make(map[string]interface{})
+		for _, item := range drift.Items {
+			if item.Missing {
+				continue
+			}
+			if item.Kind == DRIFT_KIND_PARAMETER {
+				parameters[item.Name] = baseline.Parameters[item.Name]
+			} else {
+				variables[item.Name] = baseline.Variables[item.Name]
+			}
+		}
+		fixErrors := make([]string, 0)
+		if len(parameters) != 0 {
+			if err := c.SetTenantParameters(drift.TenantName, parameters); err != nil {
+				fixErrors = append(fixErrors, fmt.Sprintf("set parameters failed: %v", err))
+			} else {
+				markDriftItemsFixed(drift, DRIFT_KIND_PARAMETER)
+			}
+		}
+		if len(variables) != 0 {
+			if err := c.SetTenantVariables(drift.TenantName, variables); err != nil {
+				fixErrors = append(fixErrors, fmt.Sprintf("set variables failed: %v", err))
+			} else {
+				markDriftItemsFixed(drift, DRIFT_KIND_VARIABLE)
+			}
+		}
+		drift.FixError = strings.Join(fixErrors, "; ")
+		drift.Fixed = true
+		for _, item := range drift.Items {
+			if !item.Fixed {
+				drift.Fixed = false
+				break
+			}
+		}
+	}
+}
+
+// markDriftItemsFixed marks the items of the kind which are not missing as fixed,
+// or as pending restart if the parameter only takes effect after restart.
+func markDriftItemsFixed(drift *TenantDrift, kind string) {
+	for i := range drift.Items {
+		item := &drift.Items[i]
+		if item.Kind != kind || item.Missing {
+			continue
+		}
+		if strings.EqualFold(item.EditLevel, model.EDIT_LEVEL_STATIC_EFFECTIVE) {
+			item.PendingRestart = true
+		} else {
+			item.Fixed = true
+		}
+	}
+}
+
+// DetectAndFixTenantDrift detects the drift of every tenant and fixes it.
+func (c *Client) DetectAndFixTenantDrift(baseline DriftBaseline) (*DriftReport, error) {
+	report, err := c.DetectTenantDrift(baseline)
+	if err != nil {
+		return nil, err
+	}
+	c.FixTenantDrift(report, baseline)
+	return report, nil
+}
@@ -0,0 +1,90 @@
+/*
+ * Copyright (c) 2024 OceanBase.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1
+
+import (
+	"github.com/oceanbase/obshell-sdk-go/model"
+)
+
+// LocalityToZoneParams converts the locality to the []ZoneParam, which can be used by CreateTenant, ScaleOutReplicas and ModifyTenantReplicas.
+// unitConfigName and unitNum are applied to every zone, leave them empty when used by ModifyTenantReplicas to keep the units unchanged.
+// ZoneParam has only one replica type, so the FULL replica is used when a zone has several replicas.
+func LocalityToZoneParams(locality *model.Locality, unitConfigName string, unitNum int) []ZoneParam {
+	params := make([]ZoneParam, 0, len(locality.Replicas))
+	for _, zone := range locality.Zones() {
+		replica := locality.GetReplica(zone)
+		params = append(params, ZoneParam{
+			Name:           replica.Zone,
+			ReplicaType:    replica.Type,
+			UnitConfigName: unitConfigName,
+			UnitNum:        unitNum,
+		})
+	}
+	return params
+}
+
+// ZoneParamsToLocality converts the []ZoneParam to the locality, the empty replica type is considered as FULL.
+func ZoneParamsToLocality(params []ZoneParam) *model.Locality {
+	locality := &model.Locality{Replicas: make([]model.ReplicaDescriptor, 0, len(params))}
+	for _, param := range params {
+		replicaType := param.ReplicaType
+		if replicaType == "" {
+			replicaType = model.REPLICA_TYPE_FULL
+		}
+		locality.Replicas = append(locality.Replicas, model.ReplicaDescriptor{
+			Type: replicaType,
+			Num:  1,
+			Zone: param.Name,
+		})
+	}
+	return locality
+}
+
+// ValidateLocality checks whether all the zones in the locality exist in the cluster.
+func (c *Client) ValidateLocality(locality *model.Locality) error {
+	obInfo, err := c.GetObInfo()
+	if err != nil {
+		return err
+	}
+	return locality.Validate(obInfo.Config.Zones())
+}
+
+// ValidatePrimaryZone checks whether all the zones in the primary zone exist in the cluster.
+func (c *Client) ValidatePrimaryZone(primaryZone *model.PrimaryZone) error {
+	obInfo, err := c.GetObInfo()
+	if err != nil {
+		return err
+	}
+	return primaryZone.Validate(obInfo.Config.Zones())
+}
+
+// GetTenantLocality returns the parsed locality and primary zone of a tenant.
+func (c *Client) GetTenantLocality(tenantName string) (*model.Locality, *model.PrimaryZone, error) {
+	tenant, err := c.GetTenantInfo(tenantName)
+	if err != nil {
+		return nil, nil, err
+	}
+	locality, err := model.ParseLocality(tenant.Locality)
+	if err != nil {
+		return nil, nil, err
+	}
+	primaryZone, err := model.ParsePrimaryZone(tenant.PrimaryZone)
+	if err != nil {
+		return nil, nil, err
+	}
+	return locality, primaryZone, nil
+}
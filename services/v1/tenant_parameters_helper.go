@@ -0,0 +1,131 @@
+/*
+ * Copyright (c) 2024 OceanBase.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/pkg/errors"
+
+	"github.com/oceanbase/obshell-sdk-go/model"
+)
+
+var (
+	ErrParameterNotFound   = errors.New("parameter not found")
+	ErrParameterReadOnly   = errors.New("parameter is read only")
+	ErrParameterInvalid    = errors.New("parameter value is invalid")
+	ErrParameterNotApplied = errors.New("parameter value is not applied")
+)
+
+// ParameterCheckResult is the result of checking a parameter before setting it.
+type ParameterCheckResult struct {
+	Name        string
+	Value       string
+	Current     *model.ParameterInfo // nil if the parameter is not found.
+	NeedRestart bool                 // The observer needs to be restarted to make the modification take effect.
+	Err         error                // Wrapped with ErrParameterNotFound, ErrParameterReadOnly or ErrParameterInvalid.
+}
+
+// ParameterSetResult is the result of setting a parameter by BatchSetTenantParameters.
+type ParameterSetResult struct {
+	Name        string
+	Expected    string
+	Actual      string // The value read back after setting.
+	NeedRestart bool
+	Err         error
+}
+
+// CheckTenantParameters checks the parameters against their data type and edit level on the client side.
+// tenantName: the name of the tenant.
+// parameters: the parameters to be set.
+// The results are sorted by the parameter name, the error is non-nil only when the parameters could not be queried.
+func (c *Client) CheckTenantParameters(tenantName string, parameters map[string]interface{}) ([]ParameterCheckResult, error) {
+	current, err := c.GetTenantParameters(tenantName)
+	if err != nil {
+		return nil, err
+	}
+	currentMap := make(map[string]*model.ParameterInfo, len(current))
+	for i := range current {
+		currentMap[current[i].Name] = &current[i]
+	}
+
+	results := make([]ParameterCheckResult, 0, len(parameters))
+	for _, name := range sortedKeys(parameters) {
+		result := ParameterCheckResult{
+			Name:  name,
+			Value: fmt.Sprint(parameters[name]),
+		}
+		if info, ok := currentMap[name]; !ok {
+			result.Err = errors.Wrap(ErrParameterNotFound, name)
+		} else {
+			result.Current = info
+			result.NeedRestart = info.NeedRestart()
+			if info.IsReadOnly() {
+				result.Err = errors.Wrap(ErrParameterReadOnly, name)
+			} else if err := model.ValidateParameterValue(info.DataType, result.Value); err != nil {
+				result.Err = errors.Wrap(ErrParameterInvalid, err.Error())
+			}
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// BatchSetTenantParameters sets the parameters one by one and reads back the values to confirm the change.
+// tenantName: the name of the tenant.
+// parameters: the parameters to be set.
+// The results are sorted by the parameter name, and each of them carries its own error.
+// The error is non-nil if any parameter failed to be set or confirmed.
+func (c *Client) BatchSetTenantParameters(tenantName string, parameters map[string]interface{}) ([]ParameterSetResult, error) {
+	results := make([]ParameterSetResult, 0, len(parameters))
+	failed := make([]string, 0)
+	for _, name := range sortedKeys(parameters) {
+		result := ParameterSetResult{
+			Name:     name,
+			Expected: fmt.Sprint(parameters[name]),
+		}
+		if err := c.SetTenantParameters(tenantName, map[string]interface{}{name: parameters[name]}); err != nil {
+			result.Err = err
+		} else if info, err := c.GetTenantParameter(tenantName, name); err != nil {
+			result.Err = errors.Wrap(err, "read back parameter failed")
+		} else {
+			result.Actual = info.Value
+			result.NeedRestart = info.NeedRestart()
+			if !model.EqualParameterValue(info.DataType, result.Expected, result.Actual) {
+				result.Err = errors.Wrapf(ErrParameterNotApplied, "%s expected '%s' but got '%s'", name, result.Expected, result.Actual)
+			}
+		}
+		if result.Err != nil {
+			failed = append(failed, name)
+		}
+		results = append(results, result)
+	}
+	if len(failed) != 0 {
+		return results, fmt.Errorf("failed to set parameters: %v", failed)
+	}
+	return results, nil
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,105 @@
+/*
+ * Copyright (c) 2024 OceanBase.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/oceanbase/obshell-sdk-go/model"
+)
+
+// UtilizationThresholds is the utilization thresholds of each resource, such as 0.8 for 80%.
+// The resource whose threshold is 0 will not be checked.
+type UtilizationThresholds map[string]float64
+
+// DefaultUtilizationThresholds returns the thresholds of 80% for cpu, memory and log disk.
+func DefaultUtilizationThresholds() UtilizationThresholds {
+	return UtilizationThresholds{
+		model.RESOURCE_CPU:      0.8,
+		model.RESOURCE_MEMORY:   0.8,
+		model.RESOURCE_LOG_DISK: 0.8,
+	}
+}
+
+// UtilizationAlert is a resource of a tenant or unit whose utilization is above the threshold.
+type UtilizationAlert struct {
+	TenantName  string
+	UnitId      int    // 0 means the alert is for the whole tenant.
+	Server      string // The 'ip:port' of the unit, empty for the whole tenant.
+	Resource    string
+	Utilization float64
+	Threshold   float64
+}
+
+func (a UtilizationAlert) String() string {
+	target := a.TenantName
+	if a.UnitId != 0 {
+		target = fmt.Sprintf("%s(unit %d on %s)", a.TenantName, a.UnitId, a.Server)
+	}
+	return fmt.Sprintf("%s %s utilization %.2f%% is above %.2f%%", target, a.Resource, a.Utilization*100, a.Threshold*100)
+}
+
+// CheckTenantsUtilization returns the tenants and units whose utilization is above the thresholds.
+// thresholds: the utilization thresholds, DefaultUtilizationThresholds will be used if it is nil.
+// The alerts of the whole tenant come before the alerts of its units.
+func (c *Client) CheckTenantsUtilization(thresholds UtilizationThresholds) ([]UtilizationAlert, error) {
+	stats, err := c.GetAllTenantResourceStats()
+	if err != nil {
+		return nil, err
+	}
+	if thresholds == nil {
+		thresholds = DefaultUtilizationThresholds()
+	}
+
+	resources := make([]string, 0, len(thresholds))
+	for resource := range thresholds {
+		resources = append(resources, resource)
+	}
+	sort.Strings(resources)
+
+	alerts := make([]UtilizationAlert, 0)
+	for _, tenant := range stats {
+		utilization := tenant.Utilization()
+		for _, resource := range resources {
+			if threshold := thresholds[resource]; threshold > 0 && utilization[resource] > threshold {
+				alerts = append(alerts, UtilizationAlert{
+					TenantName:  tenant.TenantName,
+					Resource:    resource,
+					Utilization: utilization[resource],
+					Threshold:   threshold,
+				})
+			}
+		}
+		for _, unit := range tenant.Units {
+			utilization := unit.Utilization()
+			for _, resource := range resources {
+				if threshold := thresholds[resource]; threshold > 0 && utilization[resource] > threshold {
+					alerts = append(alerts, UtilizationAlert{
+						TenantName:  tenant.TenantName,
+						UnitId:      unit.UnitId,
+						Server:      fmt.Sprintf("%s:%d", unit.SvrIp, unit.SvrPort),
+						Resource:    resource,
+						Utilization: utilization[resource],
+						Threshold:   threshold,
+					})
+				}
+			}
+		}
+	}
+	return alerts, nil
+}
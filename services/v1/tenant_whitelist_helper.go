@@ -0,0 +1,128 @@
+/*
+ * Copyright (c) 2024 OceanBase.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1
+
+import (
+	"fmt"
+
+	"github.com/oceanbase/obshell-sdk-go/model"
+)
+
+// The times to retry when the whitelist is overwritten by other writers during read-modify-write.
+const whitelistModifyRetryTimes = 3
+
+// AddTenantWhitelistEntries adds the entries to the whitelist of a tenant and returns the whitelist after modification.
+// tenantName: the name of the tenant.
+// entries: the IP, CIDR or wildcard entries to be added.
+// The whitelist is read back after writing, and it will be retried if the entries are overwritten by other writers.
+// The entries written by other writers at the same time may be dropped, see modifyTenantWhitelist.
+func (c *Client) AddTenantWhitelistEntries(tenantName string, entries ...string) (*model.Whitelist, error) {
+	if err := validateWhitelistEntries(entries); err != nil {
+		return nil, err
+	}
+	return c.modifyTenantWhitelist(tenantName, func(w *model.Whitelist) error {
+		return w.Add(entries...)
+	}, func(w *model.Whitelist) bool {
+		for _, entry := range entries {
+			if !w.Contains(entry) {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+// RemoveTenantWhitelistEntries removes the entries from the whitelist of a tenant and returns the whitelist after modification.
+// tenantName: the name of the tenant.
+// entries: the IP, CIDR or wildcard entries to be removed.
+// The whitelist is read back after writing, and it will be retried if the entries are restored by other writers.
+// The entries written by other writers at the same time may be dropped, see modifyTenantWhitelist.
+func (c *Client) RemoveTenantWhitelistEntries(tenantName string, entries ...string) (*model.Whitelist, error) {
+	if err := validateWhitelistEntries(entries); err != nil {
+		return nil, err
+	}
+	return c.modifyTenantWhitelist(tenantName, func(w *model.Whitelist) error {
+		return w.Remove(entries...)
+	}, func(w *model.Whitelist) bool {
+		for _, entry := range entries {
+			if w.Contains(entry) {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+// validateWhitelistEntries validates the given entries only, the entries already in the whitelist are kept as is.
+func validateWhitelistEntries(entries []string) error {
+	for _, entry := range entries {
+		if _, err := model.NormalizeWhitelistEntry(entry); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetTenantWhitelist returns the parsed whitelist of a tenant, the entries are kept verbatim.
+func (c *Client) GetTenantWhitelist(tenantName string) (*model.Whitelist, error) {
+	tenant, err := c.GetTenantInfo(tenantName)
+	if err != nil {
+		return nil, err
+	}
+	return model.ParseWhitelist(tenant.WhiteList)
+}
+
+// modifyTenantWhitelist modifies the whitelist by read-modify-write. The whitelist is compared with a fresh read
+// right before writing, but the agent has no compare-and-set for it, so an entry written by another writer
+// between the last read and the write may still be dropped.
+func (c *Client) modifyTenantWhitelist(tenantName string, modify func(*model.Whitelist) error, verify func(*model.Whitelist) bool) (*model.Whitelist, error) {
+	for i := 0; i < whitelistModifyRetryTimes; i++ {
+		whitelist, err := c.GetTenantWhitelist(tenantName)
+		if err != nil {
+			return nil, err
+		}
+		if verify(whitelist) {
+			return whitelist, nil
+		}
+		original := whitelist.String()
+		if err = modify(whitelist); err != nil {
+			return nil, err
+		}
+		if whitelist.Len() == 0 {
+			return nil, fmt.Errorf("the whitelist of tenant '%s' can not be empty", tenantName)
+		}
+		// Read again right before writing, and start over if it has been changed by other writers.
+		current, err := c.GetTenantWhitelist(tenantName)
+		if err != nil {
+			return nil, err
+		}
+		if current.String() != original {
+			continue
+		}
+		if err = c.SetTenantWhitelist(tenantName, whitelist.String()); err != nil {
+			return nil, err
+		}
+
+		if whitelist, err = c.GetTenantWhitelist(tenantName); err != nil {
+			return nil, err
+		}
+		if verify(whitelist) {
+			return whitelist, nil
+		}
+	}
+	return nil, fmt.Errorf("the whitelist of tenant '%s' is modified concurrently, retried %d times", tenantName, whitelistModifyRetryTimes)
+}
@@ -0,0 +1,62 @@
+/*
+ * Copyright (c) 2024 OceanBase.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1
+
+import (
+	"fmt"
+
+	"github.com/oceanbase/obshell-sdk-go/sdk/request"
+	"github.com/oceanbase/obshell-sdk-go/sdk/response"
+)
+
+type UnlockTenantUserRequest struct {
+	*request.BaseRequest
+}
+
+type unlockTenantUserResponse struct {
+	*response.OcsAgentResponse
+}
+
+func (c *Client) createUnlockTenantUserResponse() *unlockTenantUserResponse {
+	return &unlockTenantUserResponse{
+		OcsAgentResponse: response.NewOcsAgentResponseWithoutReturn(),
+	}
+}
+
+// NewUnlockTenantUserRequest return a UnlockTenantUserRequest, which can be used as the argument for the UnlockTenantUserWithRequest.
+// tenantName: the name of the tenant.
+// userName: the name of the user.
+func (c *Client) NewUnlockTenantUserRequest(tenantName string, userName string) *UnlockTenantUserRequest {
+	req := &UnlockTenantUserRequest{
+		BaseRequest: request.NewBaseRequest(),
+	}
+	req.SetAuthentication()
+	req.InitApiInfo(fmt.Sprintf("/api/v1/tenant/%s/user/%s/lock", tenantName, userName), c.GetHost(), c.GetPort(), "DELETE")
+	return req
+}
+
+// UnlockTenantUser unlocks a user of the tenant.
+func (c *Client) UnlockTenantUser(tenantName string, userName string) error {
+	request := c.NewUnlockTenantUserRequest(tenantName, userName)
+	return c.UnlockTenantUserWithRequest(request)
+}
+
+// UnlockTenantUserWithRequest unlocks a user of the tenant with a UnlockTenantUserRequest.
+func (c *Client) UnlockTenantUserWithRequest(request *UnlockTenantUserRequest) error {
+	response := c.createUnlockTenantUserResponse()
+	return c.Execute(request, response)
+}
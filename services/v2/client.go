@@ -21,3 +21,9 @@ import "github.com/oceanbase/obshell-sdk-go/sdk"
 type Client struct {
 	*sdk.Client
 }
+
+// NewClientWithSdk creates a client on an existing sdk.Client,
+// so that the auth and the routing are shared with the other versions of the client.
+func NewClientWithSdk(c *sdk.Client) *Client {
+	return &Client{Client: c}
+}
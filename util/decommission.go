@@ -0,0 +1,368 @@
+/*
+ * Copyright (c) 2024 OceanBase.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package util
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/oceanbase/obshell-sdk-go/log"
+	"github.com/oceanbase/obshell-sdk-go/model"
+	v1 "github.com/oceanbase/obshell-sdk-go/services/v1"
+)
+
+const (
+	DECOMMISSION_STEP_CHECK    = "check"
+	DECOMMISSION_STEP_SCALE_IN = "scale in"
+	DECOMMISSION_STEP_MIGRATE  = "migrate units"
+	DECOMMISSION_STEP_CLEAN    = "clean node"
+
+	defaultDecommissionPollInterval = 5 * time.Second
+)
+
+var ErrDecommissionRefused = errors.New("decommission is refused")
+
+// DecommissionCheck is the result of the checks before decommissioning a server.
+type DecommissionCheck struct {
+	Server   *model.ServerConfig
+	Zone     string
+	Units    int      // The number of the units on the server.
+	Warnings []string // The risks which need to be confirmed.
+	Blockers []string // The problems which make the decommission unsafe, such as breaking the majority.
+	// UnitsEstimated means Units is an upper bound estimated from the resource pools(a server holds at most one unit of a pool),
+	// as the agent does not serve the zone API.
+	UnitsEstimated bool
+}
+
+// DecommissionProgress is reported by DecommissionServer.
+type DecommissionProgress struct {
+	Step           string
+	Done           bool
+	Err            error
+	Dag            *model.DagDetailDTO // The task of scale in.
+	RemainingUnits int                 // The number of the units still on the server, only set when Step is DECOMMISSION_STEP_MIGRATE.
+}
+
+// DecommissionOptions is the options of DecommissionServer.
+type DecommissionOptions struct {
+	Ip   string // The ip of the agent to be decommissioned.
+	Port int    // The port of the agent to be decommissioned.
+	// Node is used to clean the node after scaling in, the node will not be cleaned if it is nil.
+	Node *NodeConfig
+	// IgnoreWarnings allows the decommission to go on when there are warnings, the blockers can never be ignored.
+	IgnoreWarnings bool
+	// ForceKill kills the observer forcely when scaling in.
+	ForceKill    bool
+	PollInterval time.Duration // The interval to poll the migration progress, default is 5 seconds.
+	OnProgress   func(DecommissionProgress)
+}
+
+// CheckDecommission checks whether the server can be removed from the cluster safely.
+// For every tenant which has a replica on the zone of the server, it checks
+// whether the remaining servers of the zone can hold the units of the tenant,
+// and whether losing the zone breaks the majority of the FULL replicas,
+// taking the FULL replicas already unavailable in the other zones into account.
+func CheckDecommission(client *v1.Client, ip string, port int) (*DecommissionCheck, error) {
+	obInfo, err := client.GetObInfo()
+	if err != nil {
+		return nil, err
+	}
+	target := fmt.Sprintf("%s:%d", ip, port)
+	check := &DecommissionCheck{}
+	units := newZoneUnitsCache(client)
+	var remaining int
+	for zone, servers := range obInfo.Config.ZoneConfig {
+		for _, server := range servers {
+			if server.AgentAddr() == target {
+				check.Server = server
+				check.Zone = zone
+			}
+		}
+		if check.Server != nil {
+			for _, server := range servers {
+				if server.AgentAddr() != target && server.IsActive() {
+					remaining++
+				}
+			}
+			break
+		}
+	}
+	if check.Server == nil {
+		return nil, fmt.Errorf("server %s is not in the cluster", target)
+	}
+	if check.Server.WithRootSvr == "YES" {
+		check.Warnings = append(check.Warnings, fmt.Sprintf("server %s hosts the rootservice, it will be switched to another server", target))
+	}
+	if check.Units, check.UnitsEstimated, err = countUnitsOnServer(client, units, check.Zone, check.Server); err != nil {
+		return nil, err
+	}
+
+	tenants, err := client.GetAllTenantOverview()
+	if err != nil {
+		return nil, err
+	}
+	for _, overview := range tenants {
+		locality, err := model.ParseLocality(overview.Locality)
+		if err != nil {
+			return nil, errors.Wrapf(err, "parse locality of tenant '%s'", overview.Name)
+		}
+		replicas := locality.GetReplicas(check.Zone)
+		if len(replicas) == 0 {
+			continue
+		}
+		if remaining == 0 {
+			unavailable, err := countUnavailableFullReplicas(units, obInfo.Config.ZoneConfig, overview.Name, locality, check.Zone)
+			if err != nil {
+				return nil, err
+			}
+			for i := range replicas {
+				checkReplicaLost(check, overview.Name, locality, &replicas[i], unavailable)
+			}
+			continue
+		}
+
+		tenant, err := client.GetTenantInfo(overview.Name)
+		if err != nil {
+			return nil, err
+		}
+		for _, pool := range tenant.Pools {
+			if !containsZone(pool.ZoneList, check.Zone) {
+				continue
+			}
+			if remaining < pool.UnitNum {
+				check.Blockers = append(check.Blockers, fmt.Sprintf("tenant '%s' needs %d units in zone '%s', but only %d servers will remain", overview.Name, pool.UnitNum, check.Zone, remaining))
+			}
+		}
+	}
+	return check, nil
+}
+
+// checkReplicaLost checks the tenant when the replica on the zone will be lost.
+// unavailable: the number of the FULL replicas of the tenant which are already unavailable in the other zones.
+func checkReplicaLost(check *DecommissionCheck, tenantName string, locality *model.Locality, replica *model.ReplicaDescriptor, unavailable int) {
+	if replica.Type != model.REPLICA_TYPE_FULL {
+		check.Warnings = append(check.Warnings, fmt.Sprintf("tenant '%s' will lose the %s replica in zone '%s'", tenantName, replica.Type, check.Zone))
+		return
+	}
+	fullReplicas := 0
+	for _, r := range locality.Replicas {
+		if r.Type == model.REPLICA_TYPE_FULL {
+			fullReplicas++
+		}
+	}
+	if left := fullReplicas - 1 - unavailable; left < fullReplicas/2+1 {
+		check.Blockers = append(check.Blockers, fmt.Sprintf("tenant '%s' will lose the majority of FULL replicas(%d of %d left, %d already unavailable)", tenantName, left, fullReplicas, unavailable))
+	} else {
+		check.Warnings = append(check.Warnings, fmt.Sprintf("tenant '%s' will lose the FULL replica in zone '%s'", tenantName, check.Zone))
+	}
+}
+
+func containsZone(zoneList string, zone string) bool {
+	for _, z := range strings.Split(zoneList, ";") {
+		if strings.TrimSpace(z) == zone {
+			return true
+		}
+	}
+	return false
+}
+
+// zoneUnitsCache caches the units of the zones queried by GetZone.
+type zoneUnitsCache struct {
+	client      *v1.Client
+	units       map[string][]*model.ZoneUnit
+	unsupported bool // The agent does not serve the zone API.
+}
+
+func newZoneUnitsCache(client *v1.Client) *zoneUnitsCache {
+	return &zoneUnitsCache{
+		client: client,
+		units:  make(map[string][]*model.ZoneUnit),
+	}
+}
+
+// get returns the units of the zone, ok is false if the agent does not serve the zone API.
+func (c *zoneUnitsCache) get(zone string) (units []*model.ZoneUnit, ok bool, err error) {
+	if c.unsupported {
+		return nil, false, nil
+	}
+	if units, ok = c.units[zone]; ok {
+		return units, true, nil
+	}
+	zoneInfo, err := c.client.GetZone(zone)
+	if errors.Is(err, v1.ErrUnsupportedByAgent) {
+		c.unsupported = true
+		return nil, false, nil
+	} else if err != nil {
+		return nil, false, err
+	}
+	c.units[zone] = zoneInfo.Units
+	return zoneInfo.Units, true, nil
+}
+
+// refresh drops the cached units, so that they are queried again.
+func (c *zoneUnitsCache) refresh() {
+	c.units = make(map[string][]*model.ZoneUnit)
+}
+
+// countUnitsOnServer returns the number of the units on the server. If the agent does not serve the zone API,
+// it returns the number of the resource pools in the zone as an upper bound, and estimated is true.
+func countUnitsOnServer(client *v1.Client, cache *zoneUnitsCache, zone string, server *model.ServerConfig) (count int, estimated bool, err error) {
+	units, ok, err := cache.get(zone)
+	if err != nil {
+		return 0, false, err
+	}
+	if !ok {
+		pools, err := client.GetAllResourcePools()
+		if err != nil {
+			return 0, false, err
+		}
+		for _, pool := range pools {
+			if containsZone(pool.ZoneList, zone) {
+				count++
+			}
+		}
+		return count, true, nil
+	}
+	for _, unit := range units {
+		if unit.SvrIp == server.SvrIP && unit.SvrPort == server.SvrPort {
+			count++
+		}
+	}
+	return count, false, nil
+}
+
+// countUnavailableFullReplicas returns the number of the FULL replicas of the tenant which are unavailable
+// in the zones other than excludedZone. A replica is unavailable if its unit is on a server which is not ACTIVE,
+// or if the zone has no ACTIVE server when the units can not be queried.
+func countUnavailableFullReplicas(cache *zoneUnitsCache, zoneConfig map[string][]*model.ServerConfig, tenantName string, locality *model.Locality, excludedZone string) (int, error) {
+	unavailable := 0
+	for _, replica := range locality.Replicas {
+		if replica.Type != model.REPLICA_TYPE_FULL || replica.Zone == excludedZone {
+			continue
+		}
+		units, ok, err := cache.get(replica.Zone)
+		if err != nil {
+			return 0, err
+		}
+		if !isReplicaAvailable(zoneConfig[replica.Zone], units, ok, tenantName) {
+			unavailable++
+		}
+	}
+	return unavailable, nil
+}
+
+func isReplicaAvailable(servers []*model.ServerConfig, units []*model.ZoneUnit, unitsKnown bool, tenantName string) bool {
+	active := make(map[string]bool)
+	for _, server := range servers {
+		if server.IsActive() {
+			active[fmt.Sprintf("%s:%d", server.SvrIP, server.SvrPort)] = true
+		}
+	}
+	if !unitsKnown {
+		return len(active) != 0
+	}
+	for _, unit := range units {
+		if unit.TenantName == tenantName && !active[fmt.Sprintf("%s:%d", unit.SvrIp, unit.SvrPort)] {
+			return false
+		}
+	}
+	return len(active) != 0
+}
+
+// DecommissionServer removes a server from the cluster gracefully.
+// It checks the tenants by CheckDecommission, refuses when there is any blocker(or warning unless IgnoreWarnings is set),
+// scales in the server, monitors the migration of the units until the task is finished, and cleans the node if Node is set.
+func DecommissionServer(client *v1.Client, opts DecommissionOptions) (*DecommissionCheck, error) {
+	if opts.PollInterval <= 0 {
+		opts.PollInterval = defaultDecommissionPollInterval
+	}
+	report := func(progress DecommissionProgress) {
+		if opts.OnProgress != nil {
+			opts.OnProgress(progress)
+		}
+	}
+
+	report(DecommissionProgress{Step: DECOMMISSION_STEP_CHECK})
+	check, err := CheckDecommission(client, opts.Ip, opts.Port)
+	if err == nil {
+		if len(check.Blockers) != 0 {
+			err = errors.Wrap(ErrDecommissionRefused, strings.Join(check.Blockers, "; "))
+		} else if len(check.Warnings) != 0 && !opts.IgnoreWarnings {
+			err = errors.Wrap(ErrDecommissionRefused, strings.Join(check.Warnings, "; "))
+		}
+	}
+	report(DecommissionProgress{Step: DECOMMISSION_STEP_CHECK, Done: true, Err: err})
+	if err != nil {
+		return check, err
+	}
+	for _, warning := range check.Warnings {
+		log.Warn(warning)
+	}
+
+	report(DecommissionProgress{Step: DECOMMISSION_STEP_SCALE_IN})
+	request := client.NewScaleInRequest(opts.Ip, opts.Port)
+	if opts.ForceKill {
+		request.SetForceKill()
+	}
+	dag, err := client.ScaleInWithRequest(request)
+	report(DecommissionProgress{Step: DECOMMISSION_STEP_SCALE_IN, Done: true, Err: err, Dag: dag})
+	if err != nil {
+		return check, errors.Wrapf(err, "%s failed", DECOMMISSION_STEP_SCALE_IN)
+	}
+
+	if dag != nil && dag.GenericDTO != nil {
+		if err = monitorUnitMigration(client, check, dag.GenericID, opts.PollInterval, report); err != nil {
+			return check, errors.Wrapf(err, "%s failed", DECOMMISSION_STEP_MIGRATE)
+		}
+	}
+
+	if opts.Node != nil {
+		report(DecommissionProgress{Step: DECOMMISSION_STEP_CLEAN})
+		err = cleanNodeByConfig(*opts.Node)
+		report(DecommissionProgress{Step: DECOMMISSION_STEP_CLEAN, Done: true, Err: err})
+		if err != nil {
+			return check, errors.Wrapf(err, "%s failed", DECOMMISSION_STEP_CLEAN)
+		}
+	}
+	return check, nil
+}
+
+// monitorUnitMigration reports the remaining units on the server until the scale in task is finished.
+func monitorUnitMigration(client *v1.Client, check *DecommissionCheck, dagID string, interval time.Duration, report func(DecommissionProgress)) error {
+	remaining := check.Units
+	units := newZoneUnitsCache(client)
+	for {
+		dag, err := client.GetDag(dagID)
+		if err != nil {
+			return err
+		}
+		units.refresh()
+		if count, _, err := countUnitsOnServer(client, units, check.Zone, check.Server); err == nil {
+			remaining = count
+		}
+		if dag.IsFinished() {
+			_, err = client.WaitDagSucceed(dagID)
+			report(DecommissionProgress{Step: DECOMMISSION_STEP_MIGRATE, Done: true, Err: err, Dag: dag, RemainingUnits: remaining})
+			return err
+		}
+		report(DecommissionProgress{Step: DECOMMISSION_STEP_MIGRATE, Dag: dag, RemainingUnits: remaining})
+		time.Sleep(interval)
+	}
+}
@@ -0,0 +1,177 @@
+/*
+ * Copyright (c) 2024 OceanBase.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package util
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/oceanbase/obshell-sdk-go/model"
+)
+
+const (
+	PRECHECK_SSH        = "ssh"
+	PRECHECK_PORT       = "port"
+	PRECHECK_DISK       = "disk"
+	PRECHECK_ULIMIT     = "ulimit"
+	PRECHECK_CLOCK_SKEW = "clock skew"
+)
+
+const (
+	unlimited            = "unlimited"
+	bytesPerGB           = 1 << 30
+	defaultMaxClockSkew  = 100 * time.Millisecond
+	defaultMinOpenFiles  = 655350
+	defaultMinProcesses  = 655360
+	defaultMinDiskFreeGB = 20
+)
+
+// NodePrecheckOptions is the options of the checks on the nodes.
+type NodePrecheckOptions struct {
+	Ports         []int         // The ports which must be free, such as the mysql port and rpc port of observer.
+	MinDiskFreeGB uint64        // The minimum free disk space of the work dir.
+	MinOpenFiles  int           // The minimum of 'ulimit -n'.
+	MinProcesses  int           // The minimum of 'ulimit -u'.
+	MaxClockSkew  time.Duration // The maximum clock skew between the node and the local host.
+}
+
+// DefaultNodePrecheckOptions returns the default options, which checks the default ports(2881, 2882) of observer.
+func DefaultNodePrecheckOptions() NodePrecheckOptions {
+	return NodePrecheckOptions{
+		Ports:         []int{2881, 2882},
+		MinDiskFreeGB: defaultMinDiskFreeGB,
+		MinOpenFiles:  defaultMinOpenFiles,
+		MinProcesses:  defaultMinProcesses,
+		MaxClockSkew:  defaultMaxClockSkew,
+	}
+}
+
+// PrecheckNodes checks the nodes by ssh and adds the results into the report,
+// the report is usually returned by v1.Client.PrecheckAgents.
+// It checks whether the ports are free, the free disk space of the work dir, the ulimits and the clock skew.
+func PrecheckNodes(report *model.PrecheckReport, options NodePrecheckOptions, configs ...NodeConfig) {
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for _, config := range configs {
+		wg.Add(1)
+		go func(config NodeConfig) {
+			defer wg.Done()
+			nodeReport := model.NewPrecheckReport()
+			precheckNode(nodeReport, options, config)
+			mu.Lock()
+			report.Items = append(report.Items, nodeReport.Items...)
+			mu.Unlock()
+		}(config)
+	}
+	wg.Wait()
+}
+
+func precheckNode(report *model.PrecheckReport, options NodePrecheckOptions, config NodeConfig) {
+	server := fmt.Sprintf("%s:%d", config.ip, config.obshellPort)
+	client, err := NewNodeClient(config)
+	if err != nil {
+		report.Fail(server, PRECHECK_SSH, err.Error())
+		return
+	}
+	defer client.Close()
+	report.Pass(server, PRECHECK_SSH, "")
+
+	for _, port := range options.Ports {
+		ret := client.ExecuteCommand(fmt.Sprintf("(ss -ltnH 2>/dev/null || netstat -ltn 2>/dev/null) | awk '{print $4}' | grep -qE ':%d$'", port))
+		if ret.Code == 0 {
+			report.Fail(server, PRECHECK_PORT, fmt.Sprintf("port %d is in use", port))
+		} else {
+			report.Pass(server, PRECHECK_PORT, fmt.Sprintf("port %d is free", port))
+		}
+	}
+
+	if options.MinDiskFreeGB > 0 {
+		precheckDisk(report, server, client, options.MinDiskFreeGB)
+	}
+	if options.MinOpenFiles > 0 {
+		precheckUlimit(report, server, client, "-n", "open files", options.MinOpenFiles)
+	}
+	if options.MinProcesses > 0 {
+		precheckUlimit(report, server, client, "-u", "max user processes", options.MinProcesses)
+	}
+	if options.MaxClockSkew > 0 {
+		precheckClockSkew(report, server, client, options.MaxClockSkew)
+	}
+}
+
+func precheckDisk(report *model.PrecheckReport, server string, client *NodeClient, minFreeGB uint64) {
+	// The work dir may not exist yet, check the nearest existing parent.
+	cmd := fmt.Sprintf("d=%s; while [ ! -d \"$d\" ]; do d=$(dirname \"$d\"); done; df -Pk \"$d\" | tail -1 | awk '{print $4}'", client.workDir)
+	ret := client.ExecuteCommand(cmd)
+	availableKB, err := strconv.ParseUint(strings.TrimSpace(ret.Stdout), 10, 64)
+	if ret.Code != 0 || err != nil {
+		report.Warn(server, PRECHECK_DISK, fmt.Sprintf("failed to get free disk space of %s: %s", client.workDir, ret.Stderr))
+		return
+	}
+	available := availableKB * 1024
+	if available < minFreeGB*bytesPerGB {
+		report.Fail(server, PRECHECK_DISK, fmt.Sprintf("free disk space of %s is %.1fGB, less than %dGB", client.workDir, float64(available)/bytesPerGB, minFreeGB))
+	} else {
+		report.Pass(server, PRECHECK_DISK, fmt.Sprintf("free disk space of %s is %.1fGB", client.workDir, float64(available)/bytesPerGB))
+	}
+}
+
+func precheckUlimit(report *model.PrecheckReport, server string, client *NodeClient, flag string, name string, min int) {
+	ret := client.ExecuteCommand(fmt.Sprintf("ulimit %s", flag))
+	value := strings.TrimSpace(ret.Stdout)
+	if ret.Code != 0 {
+		report.Warn(server, PRECHECK_ULIMIT, fmt.Sprintf("failed to get %s: %s", name, ret.Stderr))
+		return
+	}
+	if value == unlimited {
+		report.Pass(server, PRECHECK_ULIMIT, fmt.Sprintf("%s is %s", name, value))
+		return
+	}
+	limit, err := strconv.Atoi(value)
+	if err != nil {
+		report.Warn(server, PRECHECK_ULIMIT, fmt.Sprintf("unexpected %s: %s", name, value))
+	} else if limit < min {
+		report.Warn(server, PRECHECK_ULIMIT, fmt.Sprintf("%s is %d, less than %d", name, limit, min))
+	} else {
+		report.Pass(server, PRECHECK_ULIMIT, fmt.Sprintf("%s is %d", name, limit))
+	}
+}
+
+func precheckClockSkew(report *model.PrecheckReport, server string, client *NodeClient, maxSkew time.Duration) {
+	start := time.Now()
+	ret := client.ExecuteCommand("date +%s%N")
+	end := time.Now()
+	nanos, err := strconv.ParseInt(strings.TrimSpace(ret.Stdout), 10, 64)
+	if ret.Code != 0 || err != nil {
+		report.Warn(server, PRECHECK_CLOCK_SKEW, fmt.Sprintf("failed to get the time of node: %s", ret.Stderr))
+		return
+	}
+	// Take the midpoint of the command as the local time.
+	local := start.Add(end.Sub(start) / 2)
+	skew := time.Unix(0, nanos).Sub(local)
+	if skew < 0 {
+		skew = -skew
+	}
+	if skew > maxSkew {
+		report.Fail(server, PRECHECK_CLOCK_SKEW, fmt.Sprintf("clock skew is %s, more than %s", skew, maxSkew))
+	} else {
+		report.Pass(server, PRECHECK_CLOCK_SKEW, fmt.Sprintf("clock skew is %s", skew))
+	}
+}
@@ -0,0 +1,279 @@
+/*
+ * Copyright (c) 2024 OceanBase.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package util
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/oceanbase/obshell-sdk-go/log"
+	"github.com/oceanbase/obshell-sdk-go/model"
+	v1 "github.com/oceanbase/obshell-sdk-go/services/v1"
+)
+
+const (
+	SCALE_OUT_STEP_VALIDATE      = "validate"
+	SCALE_OUT_STEP_PREPARE       = "prepare packages"
+	SCALE_OUT_STEP_INIT_NODE     = "init node"
+	SCALE_OUT_STEP_START_OBSHELL = "start obshell"
+	SCALE_OUT_STEP_SCALE_OUT     = "scale out"
+	SCALE_OUT_STEP_ROLLBACK      = "rollback"
+)
+
+// The packages which will be downloaded when ScaleOutServerOptions.RpmPackagePaths is empty.
+var ScaleOutPackages = []string{"oceanbase-ce", "oceanbase-ce-libs"}
+
+// ScaleOutProgress is reported by ScaleOutServer when a step starts or ends.
+type ScaleOutProgress struct {
+	Step string
+	Done bool                // False when the step starts, true when the step ends.
+	Err  error               // The error of the step if it is failed.
+	Dag  *model.DagDetailDTO // The task of the scale out step, only set when Step is SCALE_OUT_STEP_SCALE_OUT.
+}
+
+// ScaleOutServerOptions is the options of ScaleOutServer.
+type ScaleOutServerOptions struct {
+	Node      NodeConfig        // The node to be scaled out.
+	Zone      string            // The zone of the new server.
+	ObConfigs map[string]string // The observer configs of the new server, such as "datafile_size".
+	// RpmPackagePaths is the local rpm packages to be installed on the node.
+	// If it is empty, the packages in ScaleOutPackages matching the version of the cluster will be downloaded into PackageDir.
+	RpmPackagePaths []string
+	PackageDir      string // Must be an absolute path, default is a temporary directory which is removed at the end.
+	ForceClean      bool   // Whether to clean the work directory of the node if it is not empty.
+	OnProgress      func(ScaleOutProgress)
+}
+
+// ScaleOutServer adds a new server into the cluster which the client connects to.
+// It validates the target, installs the packages on the node, starts obshell, scales out and waits for the task to finish.
+// Once a step after installing the packages is failed, the node will be cleaned,
+// so the work dir of the node must be empty unless ForceClean is set.
+func ScaleOutServer(client *v1.Client, opts ScaleOutServerOptions) (err error) {
+	report := func(progress ScaleOutProgress) {
+		if opts.OnProgress != nil {
+			opts.OnProgress(progress)
+		}
+	}
+	runStep := func(step string, fn func() error) error {
+		report(ScaleOutProgress{Step: step})
+		err := fn()
+		report(ScaleOutProgress{Step: step, Done: true, Err: err})
+		if err != nil {
+			return errors.Wrapf(err, "%s failed", step)
+		}
+		return nil
+	}
+
+	var obInfo *model.ObInfoResp
+	if err = runStep(SCALE_OUT_STEP_VALIDATE, func() (err error) {
+		obInfo, err = validateScaleOutTarget(client, opts)
+		return
+	}); err != nil {
+		return err
+	}
+
+	packages := opts.RpmPackagePaths
+	packageDir := opts.PackageDir
+	if err = runStep(SCALE_OUT_STEP_PREPARE, func() (err error) {
+		if len(packages) != 0 {
+			return nil
+		}
+		if packageDir == "" {
+			if packageDir, err = os.MkdirTemp("", "obshell-sdk-"); err != nil {
+				return err
+			}
+		}
+		packages, err = downloadClusterPackages(obInfo.Config.Version, packageDir)
+		return
+	}); err != nil {
+		if packageDir != opts.PackageDir {
+			os.RemoveAll(packageDir)
+		}
+		return err
+	}
+	if packageDir != opts.PackageDir {
+		defer os.RemoveAll(packageDir) // The temporary directory is no longer needed once the packages are installed.
+	}
+
+	scaleOutRequested := false
+	defer func() {
+		if err == nil {
+			return
+		}
+		if rollbackErr := runStep(SCALE_OUT_STEP_ROLLBACK, func() error {
+			if scaleOutRequested {
+				// The scale out task may have registered the server before it failed.
+				if err := removeScaledOutServer(client, opts.Node); err != nil {
+					return errors.Wrap(err, "remove the server from the cluster")
+				}
+			}
+			return cleanNodeByConfig(opts.Node)
+		}); rollbackErr != nil {
+			log.Warn(rollbackErr)
+		}
+	}()
+
+	if err = runStep(SCALE_OUT_STEP_INIT_NODE, func() error {
+		return InitNodes(packages, opts.ForceClean, opts.Node)
+	}); err != nil {
+		return err
+	}
+
+	if err = runStep(SCALE_OUT_STEP_START_OBSHELL, func() error {
+		return StartObshell(opts.Node)
+	}); err != nil {
+		return err
+	}
+
+	report(ScaleOutProgress{Step: SCALE_OUT_STEP_SCALE_OUT})
+	scaleOutRequested = true
+	dag, err := client.ScaleOutWithRequest(client.NewScaleOutRequest(opts.Node.ip, opts.Node.obshellPort, opts.Zone, opts.ObConfigs))
+	if err == nil && dag != nil && dag.GenericDTO != nil {
+		report(ScaleOutProgress{Step: SCALE_OUT_STEP_SCALE_OUT, Dag: dag})
+		dag, err = client.WaitDagSucceed(dag.GenericID)
+	}
+	report(ScaleOutProgress{Step: SCALE_OUT_STEP_SCALE_OUT, Done: true, Err: err, Dag: dag})
+	if err != nil {
+		return errors.Wrapf(err, "%s failed", SCALE_OUT_STEP_SCALE_OUT)
+	}
+	return nil
+}
+
+// validateScaleOutTarget checks whether the node is not in the cluster yet and returns the ObInfoResp of the cluster.
+func validateScaleOutTarget(client *v1.Client, opts ScaleOutServerOptions) (*model.ObInfoResp, error) {
+	if opts.Zone == "" {
+		return nil, errors.New("zone is empty")
+	}
+	if opts.Node.ip == "" || opts.Node.workDir == "" {
+		return nil, errors.New("ip or work dir of the node is empty")
+	}
+	obInfo, err := client.GetObInfo()
+	if err != nil {
+		return nil, err
+	}
+	target := fmt.Sprintf("%s:%d", opts.Node.ip, opts.Node.obshellPort)
+	for _, agent := range obInfo.Agents {
+		if agent.String() == target {
+			return nil, fmt.Errorf("agent %s is already in the cluster", target)
+		}
+	}
+	for zone, servers := range obInfo.Config.ZoneConfig {
+		for _, server := range servers {
+			if server.AgentAddr() == target {
+				return nil, fmt.Errorf("server %s is already in zone '%s'", target, zone)
+			}
+		}
+	}
+
+	// The work dir will be cleaned when rollback, so it must be empty or allowed to be cleaned.
+	if !opts.ForceClean {
+		nodeClient, err := NewNodeClient(opts.Node)
+		if err != nil {
+			return nil, err
+		}
+		defer nodeClient.Close()
+		isEmpty, err := checkRemoteDirEmpty(nodeClient.Client, opts.Node.workDir)
+		if err != nil {
+			return nil, err
+		}
+		if !isEmpty {
+			return nil, fmt.Errorf("%s:%s is not empty, please clean it first", opts.Node.ip, opts.Node.workDir)
+		}
+	}
+	return obInfo, nil
+}
+
+// downloadClusterPackages downloads the packages matching the version of the cluster into destDir,
+// the version is such as '4.2.1.0' or '4.2.1.0-100000102023092807'. When the version has a build number,
+// only the packages of the same build are matched, such as the release '100000102023092807.el7'.
+func downloadClusterPackages(clusterVersion string, destDir string) ([]string, error) {
+	parts := strings.SplitN(clusterVersion, "-", 2)
+	version := parts[0]
+	if version == "" {
+		return nil, errors.New("the version of cluster is unknown")
+	}
+	build := ""
+	if len(parts) == 2 {
+		build = parts[1]
+	}
+	if !filepath.IsAbs(destDir) {
+		return nil, fmt.Errorf("package dir is not an absolute path: %s", destDir)
+	}
+
+	paths := make([]string, 0, len(ScaleOutPackages))
+	for _, name := range ScaleOutPackages {
+		path, err := downloadPackageOfBuild(destDir, PackageEntry{Name: name, Version: version}, build)
+		if err != nil {
+			return nil, err
+		}
+		paths = append(paths, path)
+	}
+	return paths, nil
+}
+
+// downloadPackageOfBuild is the same as DownloadPackage, but only the packages whose release starts with the build are matched.
+func downloadPackageOfBuild(destDir string, entry PackageEntry, build string) (string, error) {
+	for _, mirror := range OB_MIRRORS {
+		packages, err := mirror.search(entry)
+		if err != nil {
+			return "", err
+		}
+		for _, pkg := range packages {
+			if build == "" || strings.Split(pkg.Version.Release, ".")[0] == build {
+				return mirror.downloadPackage(pkg, destDir)
+			}
+		}
+	}
+	return "", fmt.Errorf("no such package: %s-%s-%s", entry.Name, entry.Version, build)
+}
+
+// removeScaledOutServer removes the server or the agent of the node from the cluster if it has been registered.
+func removeScaledOutServer(client *v1.Client, node NodeConfig) error {
+	obInfo, err := client.GetObInfo()
+	if err != nil {
+		return err
+	}
+	target := fmt.Sprintf("%s:%d", node.ip, node.obshellPort)
+	for _, servers := range obInfo.Config.ZoneConfig {
+		for _, server := range servers {
+			if server.AgentAddr() == target {
+				_, err = client.ScaleInSyncWithRequest(client.NewScaleInRequest(node.ip, node.obshellPort).SetForceKill())
+				return err
+			}
+		}
+	}
+	for _, agent := range obInfo.Agents {
+		if agent.String() == target {
+			_, err = client.RemoveSyncWithRequest(client.NewRemoveRequest(node.ip, node.obshellPort))
+			return err
+		}
+	}
+	return nil
+}
+
+func cleanNodeByConfig(config NodeConfig) error {
+	client, err := NewNodeClient(config)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	return cleanNode(client)
+}